@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 
 	"github.com/tendermint/go-amino"
-	"github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 type Codec = amino.Codec
@@ -15,8 +18,24 @@ func New() *Codec {
 	return cdc
 }
 
+// RegisterCrypto registers the keys of cryptoAmino.RegisterAmino. Only a
+// pointer to a multisig key implements crypto.PubKey, so unlike there it is
+// registered as a pointer, which amino needs to decode it into a
+// crypto.PubKey. Its encoding is the same.
 func RegisterCrypto(cdc *Codec) {
-	cryptoAmino.RegisterAmino(cdc)
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(ed25519.PubKeyEd25519{},
+		ed25519.PubKeyAminoRoute, nil)
+	cdc.RegisterConcrete(secp256k1.PubKeySecp256k1{},
+		secp256k1.PubKeyAminoRoute, nil)
+	cdc.RegisterConcrete(&multisig.PubKeyMultisigThreshold{},
+		multisig.PubKeyMultisigThresholdAminoRoute, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(ed25519.PrivKeyEd25519{},
+		ed25519.PrivKeyAminoRoute, nil)
+	cdc.RegisterConcrete(secp256k1.PrivKeySecp256k1{},
+		secp256k1.PrivKeyAminoRoute, nil)
 }
 
 func MarshalJSONIndent(cdc *Codec, obj interface{}) ([]byte, error) {
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+)
+
+// AccToValAddress returns the validator operator address of the account
+// address accAddr, such as cosmos1... to cosmosvaloper1....
+func AccToValAddress(cfg types.Bech32Config, accAddr string) string {
+	defer Recover()
+
+	addr, err := types.AccAddressFromBech32WithConfig(cfg, accAddr)
+	if err != nil {
+		Throw(err)
+	}
+
+	return types.ValAddress(addr).StringWithConfig(cfg)
+}
+
+// ValToAccAddress returns the account address of the validator operator
+// address valAddr, which holds the validator's self-delegation.
+func ValToAccAddress(cfg types.Bech32Config, valAddr string) string {
+	defer Recover()
+
+	addr, err := types.ValAddressFromBech32WithConfig(cfg, valAddr)
+	if err != nil {
+		Throw(err)
+	}
+
+	return types.AccAddress(addr).StringWithConfig(cfg)
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"fmt"
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+	"reflect"
+)
+
+// Bech32ConfigFromJS reads bech32 prefixes given either as the account prefix
+// alone, such as "sent", or as an object with a prefix key and any of the
+// account_addr, account_pub, validator_addr, validator_pub, consensus_addr and
+// consensus_pub keys. Prefixes that aren't set are derived from prefix, which
+// defaults to "cosmos", as in types.NewBech32Config.
+func Bech32ConfigFromJS(value *js.Object) (types.Bech32Config, error) {
+	if IsUndefined(value) {
+		return types.Bech32Config{}, types.ErrInvalidRequest("bech32 prefixes required but not specified")
+	}
+
+	if prefix, ok := value.Interface().(string); ok {
+		return types.NewBech32Config(prefix), nil
+	}
+
+	prefix := StringOption(value, "prefix")
+	if prefix == "" {
+		prefix = types.Bech32MainPrefix
+	}
+	cfg := types.NewBech32Config(prefix)
+
+	for key, field := range map[string]*string{
+		"account_addr":   &cfg.AccountAddr,
+		"account_pub":    &cfg.AccountPub,
+		"validator_addr": &cfg.ValidatorAddr,
+		"validator_pub":  &cfg.ValidatorPub,
+		"consensus_addr": &cfg.ConsensusAddr,
+		"consensus_pub":  &cfg.ConsensusPub,
+	} {
+		if value := StringOption(value, key); value != "" {
+			*field = value
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+var (
+	codecType        = reflect.TypeOf((*codec.Codec)(nil))
+	bech32ConfigType = reflect.TypeOf(types.Bech32Config{})
+	jsObjectType     = reflect.TypeOf((*js.Object)(nil))
+)
+
+// BindExport prepares fn to be a JS export of an app with codec cdc for the
+// chain with prefixes cfg. Exports may take a *codec.Codec, to encode or decode
+// transactions, and then a types.Bech32Config, to read or write bech32, as
+// their first parameters, followed by string, int, bool or *js.Object
+// parameters; for them BindExport returns a JS function that passes cdc and
+// cfg, as taken, and then its own arguments. Other functions are returned
+// unchanged.
+func BindExport(fn interface{}, cdc *codec.Codec, cfg types.Bech32Config) interface{} {
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+	if fnType.Kind() != reflect.Func {
+		return fn
+	}
+
+	var bound []reflect.Value
+	if fnType.NumIn() > len(bound) && fnType.In(len(bound)) == codecType {
+		bound = append(bound, reflect.ValueOf(cdc))
+	}
+	if fnType.NumIn() > len(bound) && fnType.In(len(bound)) == bech32ConfigType {
+		bound = append(bound, reflect.ValueOf(cfg))
+	}
+	if len(bound) == 0 {
+		return fn
+	}
+
+	for i := len(bound); i < fnType.NumIn(); i++ {
+		switch fnType.In(i).Kind() {
+		case reflect.String, reflect.Int, reflect.Bool:
+		default:
+			if fnType.In(i) != jsObjectType {
+				panic(fmt.Sprintf("cannot bind %s: unsupported parameter type %s", fnType, fnType.In(i)))
+			}
+		}
+	}
+
+	return js.MakeFunc(func(this *js.Object, args []*js.Object) interface{} {
+		in := append([]reflect.Value{}, bound...)
+		for i := len(bound); i < fnType.NumIn(); i++ {
+			arg := js.Undefined
+			if i-len(bound) < len(args) {
+				arg = args[i-len(bound)]
+			}
+			in = append(in, argFromJS(arg, fnType.In(i)))
+		}
+
+		out := fnValue.Call(in)
+		if len(out) == 0 {
+			return nil
+		}
+		return out[0].Interface()
+	})
+}
+
+func argFromJS(arg *js.Object, typ reflect.Type) reflect.Value {
+	switch typ.Kind() {
+	case reflect.String:
+		if IsUndefined(arg) {
+			return reflect.ValueOf("")
+		}
+		return reflect.ValueOf(arg.String())
+	case reflect.Int:
+		return reflect.ValueOf(arg.Int())
+	case reflect.Bool:
+		return reflect.ValueOf(arg.Bool())
+	default:
+		return reflect.ValueOf(arg)
+	}
+}
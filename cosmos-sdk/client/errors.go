@@ -0,0 +1,32 @@
+package client
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Throw raises err in JS as an `Error` whose `code` property is the error's
+// types.CodeType, instead of letting a Go panic cross the GopherJS boundary.
+func Throw(err error) {
+	jsErr := js.Global.Get("Error").New(err.Error())
+	jsErr.Set("code", string(types.CodeOf(err)))
+	panic(&js.Error{Object: jsErr})
+}
+
+// Recover is deferred by every export so that unexpected panics also reach
+// JS as coded errors.
+func Recover() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	switch r := r.(type) {
+	case *js.Error:
+		panic(r)
+	case error:
+		Throw(r)
+	default:
+		Throw(types.NewError(types.CodeInternal, "%v", r))
+	}
+}
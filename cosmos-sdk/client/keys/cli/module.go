@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+)
+
+// AppModule is the keystore and address conversion module. It has no
+// messages.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "keys" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {}
+
+func (AppModule) Msgs() []types.Msg {
+	return nil
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"accToValAddress":   client.AccToValAddress,
+		"valToAccAddress":   client.ValToAccAddress,
+		"createKey":         keys.CreateKey,
+		"recoverKey":        keys.RecoverKey,
+		"listKeys":          keys.ListKeys,
+		"getKey":            keys.GetKey,
+		"deleteKey":         keys.DeleteKey,
+		"updateKey":         keys.UpdateKey,
+		"exportKey":         keys.ExportKey,
+		"exportPubKey":      keys.ExportPubKey,
+		"importKey":         keys.ImportKey,
+		"createMultisigKey": keys.CreateMultisigKey,
+		"keystore":          keys.Keystore,
+		"setKeystore":       keys.SetKeystore,
+	}
+}
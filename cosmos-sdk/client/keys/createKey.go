@@ -1,6 +1,7 @@
 package keys
 
 import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
 	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
 	"github.com/baymax19/js2go/cosmos-sdk/types"
 	"github.com/cosmos/go-bip39"
@@ -8,7 +9,8 @@ import (
 )
 
 const (
-	defaultEntropySize = 256
+	defaultEntropySize     = 256
+	defaultBIP39Passphrase = ""
 )
 
 type KeyOutput struct {
@@ -19,34 +21,56 @@ type KeyOutput struct {
 	Seed    string `js:"seed"`
 }
 
-func CreateKey(name, password string) *js.Object {
+// CreateKey generates a new mnemonic and derives its key at the path set in
+// options, see hdPathFromJS.
+func CreateKey(cfg types.Bech32Config, name, password string, options *js.Object) *js.Object {
+	defer client.Recover()
+
+	path, err := hdPathFromJS(options)
+	if err != nil {
+		client.Throw(err)
+	}
 
 	entropy, err := bip39.NewEntropy(defaultEntropySize)
 	if err != nil {
-		panic(err)
+		client.Throw(err)
 	}
 
 	mnemonic, err := bip39.NewMnemonic(entropy[:])
 	if err != nil {
-		panic(err)
+		client.Throw(err)
+	}
+
+	info, err := GetKeybase().Derive(name, mnemonic, defaultBIP39Passphrase, password, path)
+	if err != nil {
+		client.Throw(err)
 	}
 
-	info, err := keybase.CreateKey(name, password, mnemonic)
+	data, err := writeInfo(cfg, info, mnemonic)
 	if err != nil {
-		panic(err)
+		client.Throw(err)
 	}
 
-	return writeInfo(info, mnemonic)
+	return data
 }
 
-func writeInfo(info keybase.Info, mnemonic string) *js.Object {
+// writeInfo returns the JS output of info with the address and public key in
+// the prefixes of cfg.
+func writeInfo(cfg types.Bech32Config, info keybase.Info, mnemonic string) (*js.Object, error) {
+
+	pubKey, err := types.PubKeyFromBytesWithConfig(cfg, info.GetPubKey())
+	if err != nil {
+		return nil, err
+	}
 
 	data := &KeyOutput{Object: js.Global.Get("Object").New()}
 
-	data.Address = types.AccAddress(info.GetAddress()).String()
-	data.PubKey = types.PubKeyFromBytes(info.GetPubKey())
+	data.Address = types.AccAddress(info.GetAddress()).StringWithConfig(cfg)
+	data.PubKey = pubKey
 	data.Name = info.GetName()
-	data.Seed = mnemonic
+	if mnemonic != "" {
+		data.Seed = mnemonic
+	}
 
-	return data.Object
+	return data.Object, nil
 }
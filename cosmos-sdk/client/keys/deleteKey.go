@@ -0,0 +1,14 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+)
+
+func DeleteKey(name, passphrase string) {
+	defer client.Recover()
+
+	err := GetKeybase().Delete(name, passphrase)
+	if err != nil {
+		client.Throw(err)
+	}
+}
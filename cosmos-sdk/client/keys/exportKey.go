@@ -0,0 +1,29 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+)
+
+// ExportKey returns the stored key as an armored private key, encrypted with
+// the same passphrase, that `gaiacli keys import` accepts.
+func ExportKey(name, passphrase string) string {
+	defer client.Recover()
+
+	armor, err := GetKeybase().ExportPrivKey(name, passphrase, passphrase)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return armor
+}
+
+func ExportPubKey(name string) string {
+	defer client.Recover()
+
+	armor, err := GetKeybase().ExportPubKey(name)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return armor
+}
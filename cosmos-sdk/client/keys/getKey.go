@@ -0,0 +1,23 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func GetKey(cfg types.Bech32Config, name string) *js.Object {
+	defer client.Recover()
+
+	info, err := GetKeybase().Get(name)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := writeInfo(cfg, info, "")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
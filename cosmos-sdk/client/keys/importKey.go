@@ -0,0 +1,25 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ImportKey stores an armored private key, such as one written by
+// `gaiacli keys export`, under name.
+func ImportKey(cfg types.Bech32Config, name, armor, passphrase string) *js.Object {
+	defer client.Recover()
+
+	info, err := GetKeybase().ImportPrivKey(name, armor, passphrase)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := writeInfo(cfg, info, "")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
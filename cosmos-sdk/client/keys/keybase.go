@@ -0,0 +1,111 @@
+package keys
+
+import (
+	"encoding/base64"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/gopherjs/gopherjs/js"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	localStoragePrefix = "js2go/keys/"
+
+	// defaultKeyDir is the FileStore directory, relative to $HOME, used when
+	// there is no localStorage.
+	defaultKeyDir = ".js2go/keys"
+
+	storeLocalStorage = "localStorage"
+	storeFile         = "file"
+	storeMemory       = "memory"
+	storeCustom       = "custom"
+)
+
+var (
+	kb      *keybase.Keybase
+	kbStore string
+	kbDir   string
+)
+
+// GetKeybase returns the keybase behind the key exports. It is backed by the
+// browser's localStorage when there is one, and otherwise by a FileStore under
+// $HOME/.js2go/keys. Keys are only kept in memory when that directory cannot
+// be created.
+func GetKeybase() keybase.Keybase {
+	if kb == nil {
+		if storage := js.Global.Get("localStorage"); !client.IsUndefined(storage) {
+			setStore(localStorage{storage: storage, prefix: localStoragePrefix}, storeLocalStorage, "")
+			return *kb
+		}
+
+		if home := os.Getenv("HOME"); home != "" && UseFileStore(filepath.Join(home, defaultKeyDir)) == nil {
+			return *kb
+		}
+
+		setStore(keybase.NewMemStore(), storeMemory, "")
+	}
+	return *kb
+}
+
+// SetKeybase replaces the keybase behind the key exports.
+func SetKeybase(keys keybase.Keybase) {
+	kb = &keys
+	kbStore, kbDir = storeCustom, ""
+}
+
+// UseFileStore backs the key exports with a FileStore under dir.
+func UseFileStore(dir string) error {
+	store, err := keybase.NewFileStore(dir)
+	if err != nil {
+		return err
+	}
+
+	setStore(store, storeFile, dir)
+	return nil
+}
+
+func setStore(store keybase.Store, name, dir string) {
+	keys := keybase.New(store)
+	kb = &keys
+	kbStore, kbDir = name, dir
+}
+
+// localStorage is a keybase.Store over the Web Storage API. Values are stored
+// base64 encoded under prefix.
+type localStorage struct {
+	storage *js.Object
+	prefix  string
+}
+
+var _ keybase.Store = localStorage{}
+
+func (store localStorage) Get(key string) ([]byte, error) {
+	value := store.storage.Call("getItem", store.prefix+key)
+	if client.IsUndefined(value) {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(value.String())
+}
+
+func (store localStorage) Set(key string, value []byte) error {
+	store.storage.Call("setItem", store.prefix+key, base64.StdEncoding.EncodeToString(value))
+	return nil
+}
+
+func (store localStorage) Delete(key string) error {
+	store.storage.Call("removeItem", store.prefix+key)
+	return nil
+}
+
+func (store localStorage) Keys() ([]string, error) {
+	var keys []string
+	for i := 0; i < store.storage.Get("length").Int(); i++ {
+		key := store.storage.Call("key", i).String()
+		if strings.HasPrefix(key, store.prefix) {
+			keys = append(keys, strings.TrimPrefix(key, store.prefix))
+		}
+	}
+	return keys, nil
+}
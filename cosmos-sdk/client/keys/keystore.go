@@ -0,0 +1,41 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type KeystoreOutput struct {
+	*js.Object
+	Type string `js:"type"`
+	Dir  string `js:"dir"`
+}
+
+// Keystore reports the store behind the key exports, see GetKeybase.
+func Keystore() *js.Object {
+	GetKeybase()
+
+	data := &KeystoreOutput{Object: js.Global.Get("Object").New()}
+	data.Type = kbStore
+	if kbDir != "" {
+		data.Dir = kbDir
+	}
+	return data.Object
+}
+
+// SetKeystore keeps keys in files under dir, or only in memory when dir is
+// empty, and reports the new store as Keystore does.
+func SetKeystore(dir string) *js.Object {
+	defer client.Recover()
+
+	if dir == "" {
+		setStore(keybase.NewMemStore(), storeMemory, "")
+		return Keystore()
+	}
+
+	if err := UseFileStore(dir); err != nil {
+		client.Throw(err)
+	}
+	return Keystore()
+}
@@ -0,0 +1,27 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func ListKeys(cfg types.Bech32Config) []*js.Object {
+	defer client.Recover()
+
+	infos, err := GetKeybase().List()
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data := make([]*js.Object, 0, len(infos))
+	for _, info := range infos {
+		output, err := writeInfo(cfg, info, "")
+		if err != nil {
+			client.Throw(err)
+		}
+		data = append(data, output)
+	}
+
+	return data
+}
@@ -0,0 +1,46 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+// CreateMultisigKey returns the address and bech32 public key of the
+// threshold-of-n multisig account of pubkeys, an array of account public keys
+// in the prefixes of cfg.
+func CreateMultisigKey(cfg types.Bech32Config, threshold int, pubkeys *js.Object) *js.Object {
+	defer client.Recover()
+
+	elems, err := client.ArrayFromJS(pubkeys, "pubkeys")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	keys := make([]tmcrypto.PubKey, len(elems))
+	for i, elem := range elems {
+		keys[i], err = types.PubKeyFromBech32StringWithConfig(cfg, elem.String())
+		if err != nil {
+			client.Throw(err)
+		}
+	}
+
+	multisigKey, err := keybase.NewMultisigPubKey(threshold, keys)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	pubKey, err := types.PubKeyFromBytesWithConfig(cfg, multisigKey)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data := &KeyOutput{Object: js.Global.Get("Object").New()}
+
+	data.Address = types.AccAddress(multisigKey.Address()).StringWithConfig(cfg)
+	data.PubKey = pubKey
+
+	return data.Object
+}
@@ -0,0 +1,42 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// RecoverKey restores a key from an existing mnemonic. options may set
+// bip39_passphrase (default "") and the derivation path, see hdPathFromJS.
+func RecoverKey(cfg types.Bech32Config, name, password, mnemonic string, options *js.Object) *js.Object {
+	defer client.Recover()
+
+	path, err := hdPathFromJS(options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	bip39Passphrase := client.StringOption(options, "bip39_passphrase")
+
+	info, err := GetKeybase().Derive(name, mnemonic, bip39Passphrase, password, path)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := writeInfo(cfg, info, mnemonic)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// hdPathFromJS reads options.hd_path when set, and otherwise the top-level
+// coin_type, account and index keys of options.
+func hdPathFromJS(options *js.Object) (keybase.HDPath, error) {
+	if !client.IsUndefined(options) && !client.IsUndefined(options.Get("hd_path")) {
+		return client.HDPathFromJS(options.Get("hd_path"))
+	}
+	return client.HDPathFromJS(options)
+}
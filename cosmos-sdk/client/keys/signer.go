@@ -0,0 +1,85 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SignerFromJS reads who signs a transaction: a mnemonic string, an object
+// {mnemonic, bip39_passphrase, hd_path} or a stored key {name, passphrase}.
+func SignerFromJS(value *js.Object) (txbuilder.Signer, error) {
+	return SignerWithPathFromJS(value, keybase.DefaultHDPath, defaultBIP39Passphrase)
+}
+
+// SignerWithPathFromJS is SignerFromJS with the path and BIP39 passphrase of a
+// mnemonic that does not set its own.
+func SignerWithPathFromJS(value *js.Object, path keybase.HDPath, bip39Passphrase string) (txbuilder.Signer, error) {
+	if client.IsUndefined(value) {
+		return nil, types.ErrInvalidRequest("signer required but not specified")
+	}
+
+	if mnemonic, ok := value.Interface().(string); ok {
+		return txbuilder.NewSeedSigner(mnemonic, bip39Passphrase, path), nil
+	}
+
+	if name := client.StringOption(value, "name"); name != "" {
+		return txbuilder.NewKeybaseSigner(GetKeybase(), name, client.StringOption(value, "passphrase")), nil
+	}
+
+	mnemonic := client.StringOption(value, "mnemonic")
+	if mnemonic == "" {
+		return nil, types.ErrInvalidRequest("signer needs a mnemonic or a key name")
+	}
+
+	if !client.IsUndefined(value.Get("hd_path")) {
+		var err error
+		path, err = client.HDPathFromJS(value.Get("hd_path"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !client.IsUndefined(value.Get("bip39_passphrase")) {
+		bip39Passphrase = client.StringOption(value, "bip39_passphrase")
+	}
+	return txbuilder.NewSeedSigner(mnemonic, bip39Passphrase, path), nil
+}
+
+// SignerAccountsFromJS reads an array of signers as in SignerFromJS. Signer
+// objects may set their own account_number and sequence, which default to
+// those of baseReq.
+func SignerAccountsFromJS(value *js.Object, baseReq txbuilder.BaseReq) ([]txbuilder.SignerAccount, error) {
+	elems, err := client.ArrayFromJS(value, "signers")
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]txbuilder.SignerAccount, len(elems))
+	for i, elem := range elems {
+		signer, err := SignerFromJS(elem)
+		if err != nil {
+			return nil, err
+		}
+
+		var options *js.Object
+		if _, ok := elem.Interface().(string); !ok {
+			options = elem
+		}
+
+		accountNumber, err := client.Uint64Option(options, "account_number", false, baseReq.AccountNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence, err := client.Uint64Option(options, "sequence", false, baseReq.Sequence)
+		if err != nil {
+			return nil, err
+		}
+
+		signers[i] = txbuilder.NewSignerAccount(signer, accountNumber, sequence)
+	}
+	return signers, nil
+}
@@ -0,0 +1,14 @@
+package keys
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+)
+
+func UpdateKey(name, oldpass, newpass string) {
+	defer client.Recover()
+
+	err := GetKeybase().Update(name, oldpass, newpass)
+	if err != nil {
+		client.Throw(err)
+	}
+}
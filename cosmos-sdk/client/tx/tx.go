@@ -0,0 +1,98 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// BuildAndSignFromJS is the common tail of the message exports: it reads the
+// base request from options as client.BaseReqFromJS does and the signer from
+// keyOrSeed as keys.SignerFromJS does, with options.hd_path and
+// options.bip39_passphrase applying to a mnemonic that does not set its own,
+// whether bare or in a {mnemonic} object. An array keyOrSeed is read with
+// keys.SignerAccountsFromJS, for messages with several signers. msgs are signed
+// with the prefixes of cfg and encoded with cdc.
+func BuildAndSignFromJS(cdc *codec.Codec, cfg types.Bech32Config, msgs []types.Msg, keyOrSeed, options *js.Object) (string, error) {
+
+	baseReq, err := client.BaseReqFromJS(cfg, options)
+	if err != nil {
+		return "", err
+	}
+
+	if client.IsArray(keyOrSeed) {
+		signers, err := keys.SignerAccountsFromJS(keyOrSeed, baseReq)
+		if err != nil {
+			return "", err
+		}
+		return BuildAndSignMulti(cdc, msgs, signers, baseReq)
+	}
+
+	path, err := baseReq.ParseHDPath()
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := keys.SignerWithPathFromJS(keyOrSeed, path, baseReq.BIP39Passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return BuildAndSign(cdc, msgs, signer, baseReq)
+}
+
+// DecodeMsgs decodes one amino JSON message, or an array of them, using cdc.
+func DecodeMsgs(cdc *codec.Codec, msgJSON []byte) ([]types.Msg, error) {
+	msgJSON = bytes.TrimSpace(msgJSON)
+
+	if bytes.HasPrefix(msgJSON, []byte("[")) {
+		var msgs []types.Msg
+		if err := cdc.UnmarshalJSON(msgJSON, &msgs); err != nil {
+			return nil, types.ErrInvalidRequest("invalid messages: %s", err)
+		}
+		if len(msgs) == 0 {
+			return nil, types.ErrInvalidRequest("no messages to sign")
+		}
+		return msgs, nil
+	}
+
+	var msg types.Msg
+	if err := cdc.UnmarshalJSON(msgJSON, &msg); err != nil {
+		return nil, types.ErrInvalidRequest("invalid message: %s", err)
+	}
+	return []types.Msg{msg}, nil
+}
+
+// BuildAndSign returns msgs signed by signer as a transaction encoded with cdc
+// in base64.
+func BuildAndSign(cdc *codec.Codec, msgs []types.Msg, signer txbuilder.Signer, baseReq txbuilder.BaseReq) (string, error) {
+	baseReq = baseReq.WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+	txBytes, err := baseReq.BuildAndSignWithSigner(signer, msgs)
+	if err != nil {
+		return "", err
+	}
+
+	data := base64.StdEncoding.EncodeToString(txBytes)
+	return data, nil
+}
+
+// BuildAndSignMulti is BuildAndSign with a signer for each of the msgs'
+// signers, in the order of their GetSigners.
+func BuildAndSignMulti(cdc *codec.Codec, msgs []types.Msg, signers []txbuilder.SignerAccount, baseReq txbuilder.BaseReq) (string, error) {
+	baseReq = baseReq.WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+	txBytes, err := baseReq.BuildAndSignMulti(signers, msgs)
+	if err != nil {
+		return "", err
+	}
+
+	data := base64.StdEncoding.EncodeToString(txBytes)
+	return data, nil
+}
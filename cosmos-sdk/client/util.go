@@ -2,8 +2,13 @@ package client
 
 import (
 	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
 	"github.com/baymax19/js2go/cosmos-sdk/types"
 	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/gopherjs/gopherjs/js"
+	"math"
+	"strconv"
 )
 
 func GetTxEncoder(cdc *codec.Codec) (encoder types.TxEncoder) {
@@ -12,3 +17,164 @@ func GetTxEncoder(cdc *codec.Codec) (encoder types.TxEncoder) {
 	}
 	return
 }
+
+// BaseReqFromJS reads a BaseReq for the chain with prefixes cfg from a JS
+// options object. chain_id and account_number are required; sequence defaults
+// to 0, gas to txbuilder.DefaultGas, and memo, fee and gas_prices to empty.
+// Numbers may be given as JS numbers or decimal strings.
+func BaseReqFromJS(cfg types.Bech32Config, options *js.Object) (txbuilder.BaseReq, error) {
+	if IsUndefined(options) {
+		return txbuilder.BaseReq{}, types.ErrInvalidRequest("options are required")
+	}
+
+	chainID := options.Get("chain_id")
+	if IsUndefined(chainID) || chainID.String() == "" {
+		return txbuilder.BaseReq{}, types.ErrInvalidRequest("chain_id required but not specified")
+	}
+
+	accountNumber, err := Uint64Option(options, "account_number", true, 0)
+	if err != nil {
+		return txbuilder.BaseReq{}, err
+	}
+
+	sequence, err := Uint64Option(options, "sequence", false, 0)
+	if err != nil {
+		return txbuilder.BaseReq{}, err
+	}
+
+	gas, err := Uint64Option(options, "gas", false, txbuilder.DefaultGas)
+	if err != nil {
+		return txbuilder.BaseReq{}, err
+	}
+
+	baseReq := *txbuilder.NewBaseReq(accountNumber, sequence, gas, chainID.String(),
+		StringOption(options, "memo"), StringOption(options, "fee"), StringOption(options, "gas_prices"))
+	baseReq = baseReq.WithBech32(cfg).WithBIP39Passphrase(StringOption(options, "bip39_passphrase"))
+
+	if !IsUndefined(options.Get("hd_path")) {
+		path, err := HDPathFromJS(options.Get("hd_path"))
+		if err != nil {
+			return txbuilder.BaseReq{}, err
+		}
+
+		baseReq = baseReq.WithHDPath(path)
+	}
+
+	return baseReq, nil
+}
+
+// HDPathFromJS reads a derivation path given either as a string such as
+// "m/44'/118'/0'/0/0" or as an object with coin_type, account and index keys.
+// Unset keys default to keybase.DefaultHDPath.
+func HDPathFromJS(value *js.Object) (keybase.HDPath, error) {
+	if IsUndefined(value) {
+		return keybase.DefaultHDPath, nil
+	}
+
+	if path, ok := value.Interface().(string); ok {
+		return keybase.ParseHDPath(path)
+	}
+
+	coinType, err := Uint32Option(value, "coin_type", keybase.DefaultCoinType)
+	if err != nil {
+		return keybase.HDPath{}, err
+	}
+
+	account, err := Uint32Option(value, "account", 0)
+	if err != nil {
+		return keybase.HDPath{}, err
+	}
+
+	index, err := Uint32Option(value, "index", 0)
+	if err != nil {
+		return keybase.HDPath{}, err
+	}
+
+	return keybase.NewHDPath(coinType, account, index)
+}
+
+// Uint64Option reads key from options as a JS number or decimal string.
+func Uint64Option(options *js.Object, key string, required bool, defaultValue uint64) (uint64, error) {
+	value := get(options, key)
+	if IsUndefined(value) && !required {
+		return defaultValue, nil
+	}
+	return Uint64FromJS(value, key)
+}
+
+// Uint64FromJS reads a required JS number or decimal string; name is used in
+// error messages.
+func Uint64FromJS(value *js.Object, name string) (uint64, error) {
+	if IsUndefined(value) {
+		return 0, types.ErrInvalidRequest("%s required but not specified", name)
+	}
+
+	n, err := strconv.ParseUint(value.String(), 10, 64)
+	if err != nil {
+		return 0, types.ErrInvalidRequest("invalid %s: %s", name, value.String())
+	}
+	return n, nil
+}
+
+// Uint32Option is Uint64Option for optional values that must fit in 32 bits.
+func Uint32Option(options *js.Object, key string, defaultValue uint32) (uint32, error) {
+	n, err := Uint64Option(options, key, false, uint64(defaultValue))
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxUint32 {
+		return 0, types.ErrInvalidRequest("%s out of range: %d", key, n)
+	}
+	return uint32(n), nil
+}
+
+// StringOption reads key from options, returning "" when it is unset.
+func StringOption(options *js.Object, key string) string {
+	value := get(options, key)
+	if IsUndefined(value) {
+		return ""
+	}
+	return value.String()
+}
+
+// ArrayFromJS returns the elements of the JS array value; name is used in
+// error messages.
+func ArrayFromJS(value *js.Object, name string) ([]*js.Object, error) {
+	if !IsArray(value) {
+		return nil, types.ErrInvalidRequest("%s must be an array", name)
+	}
+
+	elems := make([]*js.Object, value.Length())
+	for i := range elems {
+		elems[i] = value.Index(i)
+	}
+	return elems, nil
+}
+
+// JSONFromJS returns value as JSON: a string is taken to be JSON already and
+// anything else is passed through JSON.stringify.
+func JSONFromJS(value *js.Object, name string) ([]byte, error) {
+	if IsUndefined(value) {
+		return nil, types.ErrInvalidRequest("%s required but not specified", name)
+	}
+
+	if str, ok := value.Interface().(string); ok {
+		return []byte(str), nil
+	}
+	return []byte(js.Global.Get("JSON").Call("stringify", value).String()), nil
+}
+
+func IsArray(value *js.Object) bool {
+	return !IsUndefined(value) && js.Global.Get("Array").Call("isArray", value).Bool()
+}
+
+func IsUndefined(value *js.Object) bool {
+	return value == nil || value == js.Undefined
+}
+
+func get(options *js.Object, key string) *js.Object {
+	if IsUndefined(options) {
+		return js.Undefined
+	}
+	return options.Get(key)
+}
@@ -0,0 +1,17 @@
+package keybase
+
+import (
+	"github.com/baymax19/js2go/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Info)(nil), nil)
+	cdc.RegisterConcrete(localInfo{}, "crypto/keys/localInfo", nil)
+}
+
+var cdc = codec.New()
+
+func init() {
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+}
@@ -0,0 +1,107 @@
+package keybase
+
+import (
+	"fmt"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"strconv"
+	"strings"
+)
+
+const (
+	bip44Purpose = 44
+
+	DefaultCoinType = 118
+
+	// maxLevel bounds each path level; BIP32 indexes at or above 2^31 are
+	// hardened, so they cannot be written as a plain level.
+	maxLevel = 1<<31 - 1
+)
+
+// DefaultHDPath is hd.FullFundraiserPath, 44'/118'/0'/0/0.
+var DefaultHDPath = HDPath{CoinType: DefaultCoinType}
+
+// HDPath is a BIP44 derivation path, purpose'/coin_type'/account'/change/index.
+type HDPath struct {
+	CoinType uint32 `json:"coin_type"`
+	Account  uint32 `json:"account"`
+	Change   bool   `json:"change"`
+	Index    uint32 `json:"index"`
+}
+
+func NewHDPath(coinType, account, index uint32) (HDPath, error) {
+	path := HDPath{
+		CoinType: coinType,
+		Account:  account,
+		Index:    index,
+	}
+	if coinType > maxLevel || account > maxLevel || index > maxLevel {
+		return HDPath{}, types.ErrInvalidRequest("invalid HD path %s: levels must be below 2^31", path)
+	}
+	return path, nil
+}
+
+// ParseHDPath parses a path such as "m/44'/118'/0'/0/0"; the leading "m/" is
+// optional.
+func ParseHDPath(path string) (HDPath, error) {
+	parts := strings.Split(strings.TrimPrefix(path, "m/"), "/")
+	if len(parts) != 5 {
+		return HDPath{}, types.ErrInvalidRequest("invalid HD path %q: expected 5 levels, got %d", path, len(parts))
+	}
+
+	var levels [5]uint32
+	for i, part := range parts {
+		hardened := strings.HasSuffix(part, "'")
+		if hardened != (i < 3) {
+			return HDPath{}, types.ErrInvalidRequest("invalid HD path %q: only purpose, coin type and account are hardened", path)
+		}
+
+		n, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 31)
+		if err != nil {
+			return HDPath{}, types.ErrInvalidRequest("invalid HD path %q: %s", path, err)
+		}
+		levels[i] = uint32(n)
+	}
+
+	if levels[0] != bip44Purpose {
+		return HDPath{}, types.ErrInvalidRequest("invalid HD path %q: purpose must be %d'", path, bip44Purpose)
+	}
+	if levels[3] > 1 {
+		return HDPath{}, types.ErrInvalidRequest("invalid HD path %q: change must be 0 or 1", path)
+	}
+
+	return HDPath{
+		CoinType: levels[1],
+		Account:  levels[2],
+		Change:   levels[3] == 1,
+		Index:    levels[4],
+	}, nil
+}
+
+func (p HDPath) String() string {
+	change := 0
+	if p.Change {
+		change = 1
+	}
+	return fmt.Sprintf("%d'/%d'/%d'/%d/%d", bip44Purpose, p.CoinType, p.Account, change, p.Index)
+}
+
+// DerivePrivKey derives the secp256k1 key at path from a mnemonic. Key
+// creation and seed signing both go through it so they agree on the key.
+func DerivePrivKey(mnemonic, bip39Passphrase string, path HDPath) (tmcrypto.PrivKey, error) {
+	seed, err := seedFromMnemonic(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+
+	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, path.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return secp256k1.PrivKeySecp256k1(derivedPriv), nil
+}
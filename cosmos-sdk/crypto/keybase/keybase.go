@@ -1,45 +1,53 @@
 package keybase
 
 import (
-	"fmt"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
 	"github.com/cosmos/go-bip39"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"strings"
 )
 
 func CreateKey(name, password, mnemonic string) (info Info, err error) {
+	return Derive(name, mnemonic, defaultBIP39Passphrase, password, DefaultHDPath)
+}
 
-	words := strings.Split(mnemonic, " ")
-	if len(words) != 12 && len(words) != 24 {
-		err = fmt.Errorf("recovering only works with 12 word (fundraiser) or 24 word mnemonics, got: %v words", len(words))
+// CreateAccount recovers the key at 44'/118'/account'/0/index from an existing
+// mnemonic and BIP39 passphrase.
+func CreateAccount(name, mnemonic, bip39Passphrase, password string, account, index uint32) (info Info, err error) {
+	path, err := NewHDPath(DefaultCoinType, account, index)
+	if err != nil {
 		return
 	}
 
-	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, defaultBIP39Passphrase)
+	return Derive(name, mnemonic, bip39Passphrase, password, path)
+}
+
+func Derive(name, mnemonic, bip39Passphrase, password string, path HDPath) (info Info, err error) {
+
+	priv, err := DerivePrivKey(mnemonic, bip39Passphrase, path)
 	if err != nil {
 		return
 	}
 
-	info, err = persistDerivedKey(seed, password, name, hd.FullFundraiserPath)
+	info = writeLocalKey(priv, name, password)
 
 	return
 }
 
-func persistDerivedKey(seed []byte, password, name, path string) (info Info, err error) {
+func seedFromMnemonic(mnemonic, bip39Passphrase string) ([]byte, error) {
 
-	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+	words := strings.Split(mnemonic, " ")
+	if len(words) != 12 && len(words) != 24 {
+		return nil, types.ErrInvalidMnemonic("recovering only works with 12 word (fundraiser) or 24 word mnemonics, got: %v words", len(words))
+	}
 
-	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, path)
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
 	if err != nil {
-		panic(err)
+		return nil, types.ErrInvalidMnemonic("invalid mnemonic: %s", err)
 	}
 
-	info = writeLocalKey(secp256k1.PrivKeySecp256k1(derivedPriv), name, password)
-
-	return
+	return seed, nil
 }
 
 func writeLocalKey(priv tmcrypto.PrivKey, name, passpharse string) Info {
@@ -50,3 +58,175 @@ func writeLocalKey(priv tmcrypto.PrivKey, name, passpharse string) Info {
 
 	return info
 }
+
+// Keybase stores keys, each encrypted under its own passphrase, in a Store.
+type Keybase struct {
+	store Store
+}
+
+func New(store Store) Keybase {
+	return Keybase{store: store}
+}
+
+// Derive derives a key like the package level Derive and stores it under name.
+func (kb Keybase) Derive(name, mnemonic, bip39Passphrase, password string, path HDPath) (Info, error) {
+	if err := kb.checkNew(name); err != nil {
+		return nil, err
+	}
+
+	info, err := Derive(name, mnemonic, bip39Passphrase, password, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, kb.writeInfo(info)
+}
+
+func (kb Keybase) List() ([]Info, error) {
+	keys, err := kb.store.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []Info
+	for _, key := range keys {
+		if !strings.HasSuffix(key, infoSuffix) {
+			continue
+		}
+
+		info, err := kb.Get(strings.TrimSuffix(key, infoSuffix))
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (kb Keybase) Get(name string) (Info, error) {
+	bz, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, types.ErrKeyNotFound(name)
+	}
+
+	var info Info
+	err = cdc.UnmarshalBinaryLengthPrefixed(bz, &info)
+	return info, err
+}
+
+// Delete removes a key once passphrase is shown to decrypt it.
+func (kb Keybase) Delete(name, passphrase string) error {
+	if _, err := kb.privKey(name, passphrase); err != nil {
+		return err
+	}
+	return kb.store.Delete(infoKey(name))
+}
+
+// Update re-encrypts a key under newpass.
+func (kb Keybase) Update(name, oldpass, newpass string) error {
+	priv, err := kb.privKey(name, oldpass)
+	if err != nil {
+		return err
+	}
+	return kb.writeInfo(writeLocalKey(priv, name, newpass))
+}
+
+// Sign signs msg with the stored key, returning the signature and the key's
+// public key.
+func (kb Keybase) Sign(name, passphrase string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	priv, err := kb.privKey(name, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := priv.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, priv.PubKey(), nil
+}
+
+// ExportPrivKey returns the key as an ASCII armored private key encrypted
+// with encryptPassphrase, the format of `gaiacli keys export`.
+func (kb Keybase) ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (string, error) {
+	priv, err := kb.privKey(name, decryptPassphrase)
+	if err != nil {
+		return "", err
+	}
+	return mintkey.EncryptArmorPrivKey(priv, encryptPassphrase), nil
+}
+
+// ImportPrivKey stores an ASCII armored private key under name, encrypted
+// with the same passphrase that decrypts armor.
+func (kb Keybase) ImportPrivKey(name, armor, passphrase string) (Info, error) {
+	if err := kb.checkNew(name); err != nil {
+		return nil, err
+	}
+
+	priv, err := mintkey.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, types.ErrWrongPassphrase(name)
+	}
+
+	info := writeLocalKey(priv, name, passphrase)
+	return info, kb.writeInfo(info)
+}
+
+// ExportPubKey returns the key's ASCII armored public key.
+func (kb Keybase) ExportPubKey(name string) (string, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return "", err
+	}
+	return mintkey.ArmorPubKeyBytes(info.GetPubKey().Bytes()), nil
+}
+
+func (kb Keybase) privKey(name, passphrase string) (tmcrypto.PrivKey, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	linfo, ok := info.(localInfo)
+	if !ok {
+		return nil, types.NewError(types.CodeInternal, "key %s has no private key", name)
+	}
+
+	priv, err := mintkey.UnarmorDecryptPrivKey(linfo.PrivKeyArmor, passphrase)
+	if err != nil {
+		return nil, types.ErrWrongPassphrase(name)
+	}
+	return priv, nil
+}
+
+func (kb Keybase) checkNew(name string) error {
+	if name == "" {
+		return types.ErrInvalidRequest("key name required but not specified")
+	}
+
+	bz, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		return types.ErrKeyExists(name)
+	}
+	return nil
+}
+
+func (kb Keybase) writeInfo(info Info) error {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(info)
+	if err != nil {
+		return err
+	}
+	return kb.store.Set(infoKey(info.GetName()), bz)
+}
+
+const infoSuffix = ".info"
+
+func infoKey(name string) string {
+	return name + infoSuffix
+}
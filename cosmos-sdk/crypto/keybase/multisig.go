@@ -0,0 +1,35 @@
+package keybase
+
+import (
+	"bytes"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"sort"
+)
+
+// NewMultisigPubKey returns the public key of a threshold-of-len(pubkeys)
+// multisig account. Like `gaiacli keys add --multisig`, the keys are sorted by
+// address, so the result doesn't depend on the order they are given in.
+func NewMultisigPubKey(threshold int, pubkeys []tmcrypto.PubKey) (tmcrypto.PubKey, error) {
+	if threshold <= 0 {
+		return nil, types.ErrInvalidRequest("threshold must be positive, got %d", threshold)
+	}
+	if len(pubkeys) < threshold {
+		return nil, types.ErrInvalidRequest("threshold %d is more than the %d keys given", threshold, len(pubkeys))
+	}
+
+	sorted := make([]tmcrypto.PubKey, len(pubkeys))
+	copy(sorted, pubkeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address(), sorted[j].Address()) < 0
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Equals(sorted[i-1]) {
+			return nil, types.ErrInvalidPubKey("duplicate key %s", types.AccAddress(sorted[i].Address()))
+		}
+	}
+
+	return multisig.NewPubKeyMultisigThreshold(threshold, sorted), nil
+}
@@ -0,0 +1,126 @@
+package keybase
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Store is the storage backend of a Keybase. Get returns nil, nil for a
+// missing key.
+type Store interface {
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte) error
+	Delete(key string) error
+	Keys() ([]string, error)
+}
+
+// MemStore keeps keys in memory only.
+type MemStore struct {
+	mtx  sync.RWMutex
+	data map[string][]byte
+}
+
+var _ Store = (*MemStore)(nil)
+
+func NewMemStore() *MemStore {
+	return &MemStore{data: make(map[string][]byte)}
+}
+
+func (store *MemStore) Get(key string) ([]byte, error) {
+	store.mtx.RLock()
+	defer store.mtx.RUnlock()
+	return store.data[key], nil
+}
+
+func (store *MemStore) Set(key string, value []byte) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	store.data[key] = value
+	return nil
+}
+
+func (store *MemStore) Delete(key string) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	delete(store.data, key)
+	return nil
+}
+
+func (store *MemStore) Keys() ([]string, error) {
+	store.mtx.RLock()
+	defer store.mtx.RUnlock()
+
+	keys := make([]string, 0, len(store.data))
+	for key := range store.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// FileStore keeps each key in its own file under a directory.
+type FileStore struct {
+	dir string
+}
+
+var _ Store = FileStore{}
+
+func NewFileStore(dir string) (FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return FileStore{}, err
+	}
+	return FileStore{dir: dir}, nil
+}
+
+func (store FileStore) Get(key string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(store.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return bz, err
+}
+
+func (store FileStore) Set(key string, value []byte) error {
+	tmp := store.path(key) + ".tmp"
+	if err := ioutil.WriteFile(tmp, value, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, store.path(key))
+}
+
+func (store FileStore) Delete(key string) error {
+	err := os.Remove(store.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (store FileStore) Keys() ([]string, error) {
+	files, err := ioutil.ReadDir(store.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) == ".tmp" {
+			continue
+		}
+
+		key, err := url.PathUnescape(file.Name())
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (store FileStore) path(key string) string {
+	return filepath.Join(store.dir, url.PathEscape(key))
+}
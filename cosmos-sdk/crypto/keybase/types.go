@@ -22,20 +22,20 @@ type localInfo struct {
 	PrivKeyArmor string        `json:"priv_key_armor"`
 }
 
-var _ Info = &localInfo{}
+var _ Info = localInfo{}
 
 func newLocalInfo(name string, pubKey crypto.PubKey, privKeyArmor string) Info {
-	return &localInfo{
+	return localInfo{
 		Name:         name,
 		PubKey:       pubKey,
 		PrivKeyArmor: privKeyArmor,
 	}
 }
 
-func (info *localInfo) GetType() string { return "local" }
+func (info localInfo) GetType() string { return "local" }
 
-func (info *localInfo) GetAddress() types.AccAddress { return info.GetPubKey().Address().Bytes() }
+func (info localInfo) GetAddress() types.AccAddress { return info.GetPubKey().Address().Bytes() }
 
-func (info *localInfo) GetPubKey() crypto.PubKey { return info.PubKey }
+func (info localInfo) GetPubKey() crypto.PubKey { return info.PubKey }
 
-func (info *localInfo) GetName() string { return info.Name }
+func (info localInfo) GetName() string { return info.Name }
@@ -4,23 +4,39 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/baymax19/js2go/codec"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/tendermint/tmlibs/bech32"
 )
 
 type AccAddress []byte
 
 func AccAddressFromBech32(address string) (AccAddress, error) {
-	bz, err := GetFromBech32(address, "cosmos")
+	return AccAddressFromBech32WithConfig(DefaultBech32Config(), address)
+}
+
+// AccAddressFromBech32WithConfig parses an account address with the prefix
+// of cfg.
+func AccAddressFromBech32WithConfig(cfg Bech32Config, address string) (AccAddress, error) {
+	bz, err := GetFromBech32(address, cfg.orDefault().AccountAddr)
 	if err != nil {
-		panic(err)
+		return nil, ErrInvalidAddress("invalid address %q: %s", address, err)
 	}
 	return AccAddress(bz), nil
 }
 
 func (aa AccAddress) String() string {
-	bech32Str, err := bech32.ConvertAndEncode("cosmos", aa.Bytes())
+	return aa.Bech32String(Bech32PrefixAccAddr)
+}
+
+// StringWithConfig returns the address in bech32 with the prefix of cfg.
+func (aa AccAddress) StringWithConfig(cfg Bech32Config) string {
+	return aa.Bech32String(cfg.orDefault().AccountAddr)
+}
+
+// Bech32String returns the address in bech32 with the given prefix.
+func (aa AccAddress) Bech32String(prefix string) string {
+	bech32Str, err := bech32.ConvertAndEncode(prefix, aa.Bytes())
 	if err != nil {
 		panic(err)
 	}
@@ -58,7 +74,7 @@ func (aa *AccAddress) Unmarshal(data []byte) error {
 }
 
 func (aa AccAddress) MarshalJSON() ([]byte, error) {
-	return json.Marshal(aa.String())
+	return json.Marshal(aa.StringWithConfig(jsonConfig))
 }
 
 func (aa *AccAddress) UnmarshalJSON(data []byte) error {
@@ -68,7 +84,7 @@ func (aa *AccAddress) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	aa2, err := AccAddressFromBech32(s)
+	aa2, err := AccAddressFromBech32WithConfig(jsonConfig, s)
 	if err != nil {
 		return err
 	}
@@ -88,24 +104,258 @@ func (aa AccAddress) Format(s fmt.State, verb rune) {
 	}
 }
 
-func PubKeyFromBytes(pubkey crypto.PubKey) string {
+// ValAddress is the address of a validator operator, cosmosvaloper1....
+// It has the same bytes as the operator's AccAddress.
+type ValAddress []byte
+
+func ValAddressFromBech32(address string) (ValAddress, error) {
+	return ValAddressFromBech32WithConfig(DefaultBech32Config(), address)
+}
+
+func ValAddressFromBech32WithConfig(cfg Bech32Config, address string) (ValAddress, error) {
+	bz, err := GetFromBech32(address, cfg.orDefault().ValidatorAddr)
+	if err != nil {
+		return nil, ErrInvalidAddress("invalid validator address %q: %s", address, err)
+	}
+	return ValAddress(bz), nil
+}
+
+func (va ValAddress) String() string {
+	return va.Bech32String(Bech32PrefixValAddr)
+}
+
+// StringWithConfig returns the address in bech32 with the prefix of cfg.
+func (va ValAddress) StringWithConfig(cfg Bech32Config) string {
+	return va.Bech32String(cfg.orDefault().ValidatorAddr)
+}
 
-	PubkeyString, err := bech32.ConvertAndEncode("cosmospub", pubkey.Bytes())
+func (va ValAddress) Bech32String(prefix string) string {
+	bech32Str, err := bech32.ConvertAndEncode(prefix, va.Bytes())
 	if err != nil {
 		panic(err)
 	}
-	return PubkeyString
+	return bech32Str
+}
+
+func (va ValAddress) Bytes() []byte {
+	return va
+}
+
+func (va ValAddress) Equals(va2 ValAddress) bool {
+	if va.Empty() && va2.Empty() {
+		return true
+	}
+
+	return bytes.Compare(va.Bytes(), va2.Bytes()) == 0
+}
+
+func (va ValAddress) Empty() bool {
+	if va == nil {
+		return true
+	}
+
+	va2 := ValAddress{}
+	return bytes.Compare(va.Bytes(), va2.Bytes()) == 0
+}
+
+func (va ValAddress) Marshal() ([]byte, error) {
+	return va, nil
+}
+
+func (va *ValAddress) Unmarshal(data []byte) error {
+	*va = data
+	return nil
+}
+
+func (va ValAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(va.StringWithConfig(jsonConfig))
+}
+
+func (va *ValAddress) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	va2, err := ValAddressFromBech32WithConfig(jsonConfig, s)
+	if err != nil {
+		return err
+	}
+
+	*va = va2
+	return nil
+}
+
+func (va ValAddress) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", va.String())))
+	case 'p':
+		s.Write([]byte(fmt.Sprintf("%p", va)))
+	default:
+		s.Write([]byte(fmt.Sprintf("%X", []byte(va))))
+	}
+}
+
+// ConsAddress is the address of a validator's consensus key,
+// cosmosvalcons1....
+type ConsAddress []byte
+
+func ConsAddressFromBech32(address string) (ConsAddress, error) {
+	return ConsAddressFromBech32WithConfig(DefaultBech32Config(), address)
+}
+
+func ConsAddressFromBech32WithConfig(cfg Bech32Config, address string) (ConsAddress, error) {
+	bz, err := GetFromBech32(address, cfg.orDefault().ConsensusAddr)
+	if err != nil {
+		return nil, ErrInvalidAddress("invalid consensus address %q: %s", address, err)
+	}
+	return ConsAddress(bz), nil
+}
+
+func (ca ConsAddress) String() string {
+	return ca.Bech32String(Bech32PrefixConsAddr)
+}
+
+// StringWithConfig returns the address in bech32 with the prefix of cfg.
+func (ca ConsAddress) StringWithConfig(cfg Bech32Config) string {
+	return ca.Bech32String(cfg.orDefault().ConsensusAddr)
 }
 
-func PubKeyFromBech32String(pubkey string) crypto.PubKey {
-	bz, err := GetFromBech32(pubkey, "cosmospub")
+func (ca ConsAddress) Bech32String(prefix string) string {
+	bech32Str, err := bech32.ConvertAndEncode(prefix, ca.Bytes())
 	if err != nil {
 		panic(err)
 	}
+	return bech32Str
+}
+
+func (ca ConsAddress) Bytes() []byte {
+	return ca
+}
+
+func (ca ConsAddress) Equals(ca2 ConsAddress) bool {
+	if ca.Empty() && ca2.Empty() {
+		return true
+	}
+
+	return bytes.Compare(ca.Bytes(), ca2.Bytes()) == 0
+}
+
+func (ca ConsAddress) Empty() bool {
+	if ca == nil {
+		return true
+	}
+
+	ca2 := ConsAddress{}
+	return bytes.Compare(ca.Bytes(), ca2.Bytes()) == 0
+}
+
+func (ca ConsAddress) Marshal() ([]byte, error) {
+	return ca, nil
+}
+
+func (ca *ConsAddress) Unmarshal(data []byte) error {
+	*ca = data
+	return nil
+}
+
+func (ca ConsAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ca.StringWithConfig(jsonConfig))
+}
+
+func (ca *ConsAddress) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	ca2, err := ConsAddressFromBech32WithConfig(jsonConfig, s)
+	if err != nil {
+		return err
+	}
+
+	*ca = ca2
+	return nil
+}
 
-	pubKey, err := cryptoAmino.PubKeyFromBytes(bz)
+func (ca ConsAddress) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", ca.String())))
+	case 'p':
+		s.Write([]byte(fmt.Sprintf("%p", ca)))
+	default:
+		s.Write([]byte(fmt.Sprintf("%X", []byte(ca))))
+	}
+}
+
+func PubKeyFromBytes(pubkey crypto.PubKey) (string, error) {
+	return PubKeyFromBytesWithConfig(DefaultBech32Config(), pubkey)
+}
+
+// PubKeyFromBytesWithConfig returns an account public key in bech32 with the
+// prefix of cfg.
+func PubKeyFromBytesWithConfig(cfg Bech32Config, pubkey crypto.PubKey) (string, error) {
+	return bech32ifyPubKey(cfg.orDefault().AccountPub, pubkey)
+}
+
+func PubKeyFromBech32String(pubkey string) (crypto.PubKey, error) {
+	return PubKeyFromBech32StringWithConfig(DefaultBech32Config(), pubkey)
+}
+
+func PubKeyFromBech32StringWithConfig(cfg Bech32Config, pubkey string) (crypto.PubKey, error) {
+	return pubKeyFromBech32(cfg.orDefault().AccountPub, pubkey)
+}
+
+// ConsPubKeyFromBytes returns a validator consensus key in bech32,
+// cosmosvalconspub.
+func ConsPubKeyFromBytes(pubkey crypto.PubKey) (string, error) {
+	return ConsPubKeyFromBytesWithConfig(DefaultBech32Config(), pubkey)
+}
+
+func ConsPubKeyFromBytesWithConfig(cfg Bech32Config, pubkey crypto.PubKey) (string, error) {
+	return bech32ifyPubKey(cfg.orDefault().ConsensusPub, pubkey)
+}
+
+func ConsPubKeyFromBech32String(pubkey string) (crypto.PubKey, error) {
+	return ConsPubKeyFromBech32StringWithConfig(DefaultBech32Config(), pubkey)
+}
+
+func ConsPubKeyFromBech32StringWithConfig(cfg Bech32Config, pubkey string) (crypto.PubKey, error) {
+	return pubKeyFromBech32(cfg.orDefault().ConsensusPub, pubkey)
+}
+
+// MustBech32ifyConsPub returns pubkey in bech32 for JSON, such as sign
+// bytes, with the prefix of Bech32Config.WithJSON.
+func MustBech32ifyConsPub(pubkey crypto.PubKey) string {
+	bech32PubKey, err := ConsPubKeyFromBytesWithConfig(jsonConfig, pubkey)
 	if err != nil {
 		panic(err)
 	}
-	return pubKey
+	return bech32PubKey
+}
+
+func bech32ifyPubKey(prefix string, pubkey crypto.PubKey) (string, error) {
+
+	PubkeyString, err := bech32.ConvertAndEncode(prefix, pubkey.Bytes())
+	if err != nil {
+		return "", ErrInvalidPubKey("invalid public key: %s", err)
+	}
+	return PubkeyString, nil
+}
+
+func pubKeyFromBech32(prefix, pubkey string) (crypto.PubKey, error) {
+	bz, err := GetFromBech32(pubkey, prefix)
+	if err != nil {
+		return nil, ErrInvalidPubKey("invalid public key %q: %s", pubkey, err)
+	}
+
+	var pubKey crypto.PubKey
+	if err := codec.Cdc.UnmarshalBinaryBare(bz, &pubKey); err != nil {
+		return nil, ErrInvalidPubKey("invalid public key %q: %s", pubkey, err)
+	}
+	return pubKey, nil
 }
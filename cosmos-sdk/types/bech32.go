@@ -3,15 +3,17 @@ package types
 import (
 	"errors"
 	"fmt"
-	"github.com/tendermint/tmlibs/bech32"
+	"strings"
 )
 
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
 func GetFromBech32(bech32str, prefix string) ([]byte, error) {
 	if len(bech32str) == 0 {
 		return nil, errors.New("decoding Bech32 address failed: must provide an address")
 	}
 
-	hrp, bz, err := bech32.DecodeAndConvert(bech32str)
+	hrp, bz, err := decodeAndConvert(bech32str)
 	if err != nil {
 		return nil, err
 	}
@@ -23,3 +25,86 @@ func GetFromBech32(bech32str, prefix string) ([]byte, error) {
 	return bz, nil
 }
 
+// decodeAndConvert is bech32.DecodeAndConvert without the 90 character limit
+// of BIP 173, which the bech32 public key of a multisig account exceeds.
+func decodeAndConvert(bech string) (string, []byte, error) {
+	if strings.ToLower(bech) != bech && strings.ToUpper(bech) != bech {
+		return "", nil, errors.New("decoding bech32 failed: mixed case")
+	}
+	bech = strings.ToLower(bech)
+
+	sep := strings.LastIndex(bech, "1")
+	if sep < 1 || sep+7 > len(bech) {
+		return "", nil, errors.New("decoding bech32 failed: invalid separator index")
+	}
+
+	hrp := bech[:sep]
+	data := make([]byte, 0, len(bech)-sep-1)
+	for _, c := range bech[sep+1:] {
+		i := strings.IndexRune(bech32Charset, c)
+		if i < 0 {
+			return "", nil, fmt.Errorf("decoding bech32 failed: invalid character %q", c)
+		}
+		data = append(data, byte(i))
+	}
+
+	if bech32Polymod(append(bech32HRPExpand(hrp), data...)) != 1 {
+		return "", nil, errors.New("decoding bech32 failed: invalid checksum")
+	}
+
+	bz, err := convertBits(data[:len(data)-6], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, bz, nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := uint(0); i < 5; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var out []byte
+	acc, bits := uint(0), uint(0)
+	maxv := uint(1)<<toBits - 1
+	for _, v := range data {
+		acc = acc<<fromBits | uint(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, errors.New("decoding bech32 failed: invalid padding")
+	}
+	return out, nil
+}
@@ -318,14 +318,14 @@ func ParseCoin(coinStr string) (coin Coin, err error) {
 
 	matches := reCoin.FindStringSubmatch(coinStr)
 	if matches == nil {
-		return Coin{}, fmt.Errorf("invalid coin expression: %s", coinStr)
+		return Coin{}, ErrInvalidCoins("invalid coin expression: %s", coinStr)
 	}
 
 	denomStr, amountStr := matches[2], matches[1]
 
 	amount, ok := NewIntFromString(amountStr)
 	if !ok {
-		return Coin{}, fmt.Errorf("failed to parse coin amount: %s", amountStr)
+		return Coin{}, ErrInvalidCoins("failed to parse coin amount: %s", amountStr)
 	}
 
 	return Coin{denomStr, amount}, nil
@@ -349,7 +349,7 @@ func ParseCoins(coinsStr string) (coins Coins, err error) {
 	coins.Sort()
 
 	if !coins.IsValid() {
-		return nil, fmt.Errorf("parseCoins invalid: %#v", coins)
+		return nil, ErrInvalidCoins("parseCoins invalid: %#v", coins)
 	}
 
 	return coins, nil
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"sync"
+)
+
+const (
+	Bech32MainPrefix = "cosmos"
+
+	PrefixValidator = "val"
+	PrefixConsensus = "cons"
+	PrefixPublic    = "pub"
+	PrefixOperator  = "oper"
+
+	Bech32PrefixAccAddr  = Bech32MainPrefix
+	Bech32PrefixAccPub   = Bech32MainPrefix + PrefixPublic
+	Bech32PrefixValAddr  = Bech32MainPrefix + PrefixValidator + PrefixOperator
+	Bech32PrefixValPub   = Bech32MainPrefix + PrefixValidator + PrefixOperator + PrefixPublic
+	Bech32PrefixConsAddr = Bech32MainPrefix + PrefixValidator + PrefixConsensus
+	Bech32PrefixConsPub  = Bech32MainPrefix + PrefixValidator + PrefixConsensus + PrefixPublic
+)
+
+// Bech32Config holds the human readable parts of bech32 addresses and public
+// keys, like the SDK's sdk.Config. It is a plain value passed to whatever
+// reads or writes bech32 for a chain, so there is no global configuration;
+// the zero Bech32Config stands for DefaultBech32Config.
+//
+// Addresses marshal to JSON with the default prefixes, or with those of cfg
+// inside cfg.WithJSON.
+type Bech32Config struct {
+	AccountAddr   string `json:"account_addr"`
+	AccountPub    string `json:"account_pub"`
+	ValidatorAddr string `json:"validator_addr"`
+	ValidatorPub  string `json:"validator_pub"`
+	ConsensusAddr string `json:"consensus_addr"`
+	ConsensusPub  string `json:"consensus_pub"`
+}
+
+// NewBech32Config derives all prefixes from the account prefix, the way the
+// SDK does: "sent" gives sent, sentpub, sentvaloper, sentvaloperpub,
+// sentvalcons and sentvalconspub.
+func NewBech32Config(mainPrefix string) Bech32Config {
+	return Bech32Config{
+		AccountAddr:   mainPrefix,
+		AccountPub:    mainPrefix + PrefixPublic,
+		ValidatorAddr: mainPrefix + PrefixValidator + PrefixOperator,
+		ValidatorPub:  mainPrefix + PrefixValidator + PrefixOperator + PrefixPublic,
+		ConsensusAddr: mainPrefix + PrefixValidator + PrefixConsensus,
+		ConsensusPub:  mainPrefix + PrefixValidator + PrefixConsensus + PrefixPublic,
+	}
+}
+
+func DefaultBech32Config() Bech32Config { return NewBech32Config(Bech32MainPrefix) }
+
+// Validate checks that all prefixes are set and distinct.
+func (cfg Bech32Config) Validate() error {
+	seen := make(map[string]bool)
+	for _, prefix := range cfg.prefixes() {
+		if prefix == "" {
+			return ErrInvalidRequest("empty bech32 prefix in %+v", cfg)
+		}
+		if seen[prefix] {
+			return ErrInvalidRequest("bech32 prefix %s used twice in %+v", prefix, cfg)
+		}
+		seen[prefix] = true
+	}
+	return nil
+}
+
+func (cfg Bech32Config) orDefault() Bech32Config {
+	if cfg == (Bech32Config{}) {
+		return DefaultBech32Config()
+	}
+	return cfg
+}
+
+func (cfg Bech32Config) prefixes() []string {
+	return []string{cfg.AccountAddr, cfg.AccountPub, cfg.ValidatorAddr,
+		cfg.ValidatorPub, cfg.ConsensusAddr, cfg.ConsensusPub}
+}
+
+var (
+	jsonMtx    sync.Mutex
+	jsonConfig Bech32Config
+)
+
+// WithJSON calls fn with addresses marshalling to and unmarshalling from JSON
+// in the prefixes of cfg, so that fn can marshal sign bytes or a transaction
+// for the chain of cfg. Outside of fn they use the default prefixes. Calls of
+// WithJSON are serialized and must not be nested.
+func (cfg Bech32Config) WithJSON(fn func()) {
+	jsonMtx.Lock()
+	defer jsonMtx.Unlock()
+
+	jsonConfig = cfg
+	defer func() { jsonConfig = Bech32Config{} }()
+
+	fn()
+}
@@ -0,0 +1,396 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Coins which can have additional decimal points
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount Dec    `json:"amount"`
+}
+
+func NewDecCoin(denom string, amount Int) DecCoin {
+	if amount.LT(ZeroInt()) {
+		panic(fmt.Sprintf("negative decimal coin amount: %v\n", amount))
+	}
+
+	return DecCoin{
+		Denom:  denom,
+		Amount: NewDecFromInt(amount),
+	}
+}
+
+func NewDecCoinFromDec(denom string, amount Dec) DecCoin {
+	if amount.LT(ZeroDec()) {
+		panic(fmt.Sprintf("negative decimal coin amount: %v\n", amount))
+	}
+
+	return DecCoin{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func NewDecCoinFromCoin(coin Coin) DecCoin {
+	return DecCoin{
+		Denom:  coin.Denom,
+		Amount: NewDecFromInt(coin.Amount),
+	}
+}
+
+func NewInt64DecCoin(denom string, amount int64) DecCoin { return NewDecCoin(denom, NewInt(amount)) }
+
+func (coin DecCoin) String() string { return fmt.Sprintf("%v%v", coin.Amount, coin.Denom) }
+
+func (coin DecCoin) IsZero() bool { return coin.Amount.IsZero() }
+
+func (coin DecCoin) IsPositive() bool { return coin.Amount.IsPositive() }
+
+func (coin DecCoin) IsNegative() bool { return coin.Amount.IsNegative() }
+
+func (coin DecCoin) IsEqual(other DecCoin) bool {
+	return coin.Denom == other.Denom && coin.Amount.Equal(other.Amount)
+}
+
+func (coin DecCoin) Plus(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return DecCoin{coin.Denom, coin.Amount.Add(coinB.Amount)}
+}
+
+func (coin DecCoin) Minus(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return DecCoin{coin.Denom, coin.Amount.Sub(coinB.Amount)}
+}
+
+// TruncateDecimal returns a Coin with a truncated decimal and a DecCoin for
+// the change. Note, the change may be zero.
+func (coin DecCoin) TruncateDecimal() (Coin, DecCoin) {
+	truncated := coin.Amount.TruncateInt()
+	change := coin.Amount.Sub(NewDecFromInt(truncated))
+	return NewCoin(coin.Denom, truncated), DecCoin{coin.Denom, change}
+}
+
+// coins with decimal
+type DecCoins []DecCoin
+
+func NewDecCoins(coins Coins) DecCoins {
+	dcs := make(DecCoins, len(coins))
+
+	for i, coin := range coins {
+		dcs[i] = NewDecCoinFromCoin(coin)
+	}
+
+	return dcs
+}
+
+func (coins DecCoins) String() string {
+	if len(coins) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, coin := range coins {
+		out += fmt.Sprintf("%v,", coin.String())
+	}
+	return out[:len(out)-1]
+}
+
+// TruncateDecimal returns the coins with truncated decimals and returns the
+// change. Note, it will not return any zero-amount coins in either the truncated or
+// change coins.
+func (coins DecCoins) TruncateDecimal() (Coins, DecCoins) {
+	var truncatedCoins Coins
+	var changeCoins DecCoins
+
+	for _, coin := range coins {
+		truncated, change := coin.TruncateDecimal()
+		if !truncated.IsZero() {
+			truncatedCoins = truncatedCoins.Plus(Coins{truncated})
+		}
+		if !change.IsZero() {
+			changeCoins = changeCoins.Plus(DecCoins{change})
+		}
+	}
+
+	return truncatedCoins, changeCoins
+}
+
+func (coins DecCoins) Plus(coinsB DecCoins) DecCoins {
+	sum := ([]DecCoin)(nil)
+	indexA, indexB := 0, 0
+	lenA, lenB := len(coins), len(coinsB)
+
+	for {
+		if indexA == lenA {
+			if indexB == lenB {
+				return sum
+			}
+
+			return append(sum, removeZeroDecCoins(coinsB[indexB:])...)
+		} else if indexB == lenB {
+			return append(sum, removeZeroDecCoins(coins[indexA:])...)
+		}
+
+		coinA, coinB := coins[indexA], coinsB[indexB]
+
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1: // coin A denom < coin B denom
+			if !coinA.IsZero() {
+				sum = append(sum, coinA)
+			}
+
+			indexA++
+
+		case 0: // coin A denom == coin B denom
+			res := coinA.Plus(coinB)
+			if !res.IsZero() {
+				sum = append(sum, res)
+			}
+
+			indexA++
+			indexB++
+
+		case 1: // coin A denom > coin B denom
+			if !coinB.IsZero() {
+				sum = append(sum, coinB)
+			}
+
+			indexB++
+		}
+	}
+}
+
+func (coins DecCoins) Minus(coinsB DecCoins) DecCoins {
+	diff, hasNeg := coins.SafeMinus(coinsB)
+	if hasNeg {
+		panic("negative coin amount")
+	}
+
+	return diff
+}
+
+func (coins DecCoins) SafeMinus(coinsB DecCoins) (DecCoins, bool) {
+	diff := coins.Plus(coinsB.negative())
+	return diff, diff.IsAnyNegative()
+}
+
+func (coins DecCoins) negative() DecCoins {
+	res := make(DecCoins, 0, len(coins))
+
+	for _, coin := range coins {
+		res = append(res, DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.Neg(),
+		})
+	}
+
+	return res
+}
+
+// MulDec multiplies all the coins by a decimal
+func (coins DecCoins) MulDec(d Dec) DecCoins {
+	res := make(DecCoins, len(coins))
+	for i, coin := range coins {
+		res[i] = DecCoin{coin.Denom, coin.Amount.Mul(d)}
+	}
+	return res
+}
+
+// MulDecTruncate multiplies all the coins by a decimal, truncating
+func (coins DecCoins) MulDecTruncate(d Dec) DecCoins {
+	res := make(DecCoins, len(coins))
+	for i, coin := range coins {
+		res[i] = DecCoin{coin.Denom, coin.Amount.MulTruncate(d)}
+	}
+	return res
+}
+
+// QuoDec divides all the coins by a decimal
+func (coins DecCoins) QuoDec(d Dec) DecCoins {
+	res := make(DecCoins, len(coins))
+	for i, coin := range coins {
+		res[i] = DecCoin{coin.Denom, coin.Amount.Quo(d)}
+	}
+	return res
+}
+
+// QuoDecTruncate divides all the coins by a decimal, truncating
+func (coins DecCoins) QuoDecTruncate(d Dec) DecCoins {
+	res := make(DecCoins, len(coins))
+	for i, coin := range coins {
+		res[i] = DecCoin{coin.Denom, coin.Amount.QuoTruncate(d)}
+	}
+	return res
+}
+
+// returns the amount of a denom from deccoins
+func (coins DecCoins) AmountOf(denom string) Dec {
+	switch len(coins) {
+	case 0:
+		return ZeroDec()
+
+	case 1:
+		coin := coins[0]
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+		return ZeroDec()
+
+	default:
+		midIdx := len(coins) / 2 // 2:1, 3:1, 4:2
+		coin := coins[midIdx]
+
+		if denom < coin.Denom {
+			return coins[:midIdx].AmountOf(denom)
+		} else if denom == coin.Denom {
+			return coin.Amount
+		} else {
+			return coins[midIdx+1:].AmountOf(denom)
+		}
+	}
+}
+
+func (coins DecCoins) IsZero() bool {
+	for _, coin := range coins {
+		if !coin.Amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+func (coins DecCoins) IsAnyNegative() bool {
+	for _, coin := range coins {
+		if coin.IsNegative() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValid asserts the DecCoins are sorted, have positive amounts and no
+// duplicate denominations.
+func (coins DecCoins) IsValid() bool {
+	switch len(coins) {
+	case 0:
+		return true
+	case 1:
+		return coins[0].IsPositive()
+	default:
+		if !(DecCoins{coins[0]}).IsValid() {
+			return false
+		}
+
+		lowDenom := coins[0].Denom
+		for _, coin := range coins[1:] {
+			if coin.Denom <= lowDenom {
+				return false
+			}
+			if !coin.IsPositive() {
+				return false
+			}
+
+			lowDenom = coin.Denom
+		}
+
+		return true
+	}
+}
+
+func (coins DecCoins) IsEqual(coinsB DecCoins) bool {
+	if len(coins) != len(coinsB) {
+		return false
+	}
+
+	coins = coins.Sort()
+	coinsB = coinsB.Sort()
+
+	for i := 0; i < len(coins); i++ {
+		if !coins[i].IsEqual(coinsB[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (coins DecCoins) Empty() bool { return len(coins) == 0 }
+
+func removeZeroDecCoins(coins DecCoins) DecCoins {
+	var res DecCoins
+	for _, coin := range coins {
+		if !coin.IsZero() {
+			res = append(res, coin)
+		}
+	}
+	return res
+}
+
+func (coins DecCoins) Len() int           { return len(coins) }
+func (coins DecCoins) Less(i, j int) bool { return coins[i].Denom < coins[j].Denom }
+func (coins DecCoins) Swap(i, j int)      { coins[i], coins[j] = coins[j], coins[i] }
+
+var _ sort.Interface = DecCoins{}
+
+func (coins DecCoins) Sort() DecCoins {
+	sort.Sort(coins)
+	return coins
+}
+
+var (
+	reDecAmt  = `[[:digit:]]*\.?[[:digit:]]+`
+	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reDnm))
+)
+
+func ParseDecCoin(coinStr string) (coin DecCoin, err error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reDecCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		return DecCoin{}, ErrInvalidCoins("invalid decimal coin expression: %s", coinStr)
+	}
+
+	denomStr, amountStr := matches[2], matches[1]
+	if strings.HasPrefix(amountStr, ".") {
+		amountStr = "0" + amountStr
+	}
+
+	amount, err := NewDecFromStr(amountStr)
+	if err != nil {
+		return DecCoin{}, ErrInvalidCoins("failed to parse decimal coin amount %s: %s", amountStr, err)
+	}
+
+	return DecCoin{denomStr, amount}, nil
+}
+
+func ParseDecCoins(coinsStr string) (coins DecCoins, err error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	coinStrs := strings.Split(coinsStr, ",")
+	for _, coinStr := range coinStrs {
+		coin, err := ParseDecCoin(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+
+	coins.Sort()
+
+	if !coins.IsValid() {
+		return nil, ErrInvalidCoins("parseDecCoins invalid: %#v", coins)
+	}
+
+	return coins, nil
+}
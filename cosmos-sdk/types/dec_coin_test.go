@@ -0,0 +1,236 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewDecCoin(t *testing.T) {
+	require.NotPanics(t, func() {
+		NewDecCoin("a", NewInt(5))
+	})
+	require.NotPanics(t, func() {
+		NewDecCoin("a", NewInt(0))
+	})
+	require.Panics(t, func() {
+		NewDecCoin("a", NewInt(-5))
+	})
+}
+
+func TestNewDecCoinFromDec(t *testing.T) {
+	require.NotPanics(t, func() {
+		NewDecCoinFromDec("a", NewDec(5))
+	})
+	require.NotPanics(t, func() {
+		NewDecCoinFromDec("a", ZeroDec())
+	})
+	require.Panics(t, func() {
+		NewDecCoinFromDec("a", NewDec(-5))
+	})
+}
+
+func TestDecCoinIsPositive(t *testing.T) {
+	dc := NewDecCoin("a", NewInt(5))
+	require.True(t, dc.IsPositive())
+
+	dc = NewDecCoin("a", NewInt(0))
+	require.False(t, dc.IsPositive())
+}
+
+func TestPlusDecCoin(t *testing.T) {
+	decCoinA1 := NewDecCoinFromDec("a", NewDecWithPrec(11, 1))
+	decCoinA2 := NewDecCoinFromDec("a", NewDecWithPrec(22, 1))
+	decCoinB1 := NewDecCoinFromDec("b", NewDecWithPrec(11, 1))
+
+	// regular add
+	res := decCoinA1.Plus(decCoinA1)
+	require.True(t, decCoinA2.IsEqual(res), "sum of coins is incorrect")
+
+	// bad denom add
+	require.Panics(t, func() {
+		decCoinA1.Plus(decCoinB1)
+	}, "expected panic on sum of different denoms")
+}
+
+func TestPlusDecCoins(t *testing.T) {
+	one := NewDec(1)
+	zero := NewDec(0)
+	two := NewDec(2)
+
+	cases := []struct {
+		inputOne DecCoins
+		inputTwo DecCoins
+		expected DecCoins
+	}{
+		{DecCoins{{"atom", one}, {"btc", one}}, DecCoins{{"atom", one}, {"btc", one}}, DecCoins{{"atom", two}, {"btc", two}}},
+		{DecCoins{{"atom", zero}, {"btc", one}}, DecCoins{{"atom", zero}, {"btc", zero}}, DecCoins{{"btc", one}}},
+		{DecCoins{{"atom", zero}, {"btc", zero}}, DecCoins{{"atom", zero}, {"btc", zero}}, DecCoins(nil)},
+	}
+
+	for tcIndex, tc := range cases {
+		res := tc.inputOne.Plus(tc.inputTwo)
+		require.True(t, tc.expected.IsEqual(res), "sum of coins is incorrect, tc #%d", tcIndex)
+	}
+}
+
+func TestSortDecCoins(t *testing.T) {
+	good := DecCoins{
+		NewInt64DecCoin("gas", 1),
+		NewInt64DecCoin("mineral", 1),
+		NewInt64DecCoin("tree", 1),
+	}
+	empty := DecCoins{
+		NewInt64DecCoin("gold", 0),
+	}
+	badSort1 := DecCoins{
+		NewInt64DecCoin("tree", 1),
+		NewInt64DecCoin("gas", 1),
+		NewInt64DecCoin("mineral", 1),
+	}
+	badSort2 := DecCoins{ // both are after the first one, but the second and third are in the wrong order
+		NewInt64DecCoin("gas", 1),
+		NewInt64DecCoin("tree", 1),
+		NewInt64DecCoin("mineral", 1),
+	}
+	badAmt := DecCoins{
+		NewInt64DecCoin("gas", 1),
+		NewInt64DecCoin("tree", 0),
+		NewInt64DecCoin("mineral", 1),
+	}
+	dup := DecCoins{
+		NewInt64DecCoin("gas", 1),
+		NewInt64DecCoin("gas", 1),
+		NewInt64DecCoin("mineral", 1),
+	}
+
+	cases := []struct {
+		coins         DecCoins
+		before, after bool // valid before/after sort
+	}{
+		{good, true, true},
+		{empty, false, false},
+		{badSort1, false, true},
+		{badSort2, false, true},
+		{badAmt, false, false},
+		{dup, false, false},
+	}
+
+	for tcIndex, tc := range cases {
+		require.Equal(t, tc.before, tc.coins.IsValid(), "coin validity is incorrect before sorting, tc #%d", tcIndex)
+		tc.coins.Sort()
+		require.Equal(t, tc.after, tc.coins.IsValid(), "coin validity is incorrect after sorting, tc #%d", tcIndex)
+	}
+}
+
+func TestDecCoinsValidate(t *testing.T) {
+	testCases := []struct {
+		input        DecCoins
+		expectedPass bool
+	}{
+		{DecCoins{}, true},
+		{DecCoins{DecCoin{"4stake", OneDec()}}, true},
+		{DecCoins{DecCoin{"atom", OneDec()}, DecCoin{"stake", OneDec()}}, true},
+		{DecCoins{DecCoin{"stake", OneDec()}, DecCoin{"atom", OneDec()}}, false},
+		{DecCoins{DecCoin{"atom", ZeroDec()}}, false},
+		{DecCoins{DecCoin{"atom", NewDec(-1)}}, false},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expectedPass, tc.input.IsValid(), "unexpected result for test case #%d, input: %v", i, tc.input)
+	}
+}
+
+func TestParseDecCoins(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedResult DecCoins
+		expectedErr    bool
+	}{
+		{"", nil, false},
+		{"4stake", DecCoins{NewDecCoinFromDec("stake", NewDec(4))}, false},
+		{"5.5atom,4stake", DecCoins{
+			NewDecCoinFromDec("atom", NewDecWithPrec(55, 1)),
+			NewDecCoinFromDec("stake", NewDec(4)),
+		}, false},
+		{"0.0stake", nil, true},
+		{"0.004STAKE", DecCoins{NewDecCoinFromDec("STAKE", NewDecWithPrec(4, 3))}, false},
+		{".004stake", DecCoins{NewDecCoinFromDec("stake", NewDecWithPrec(4, 3))}, false},
+		{"0.004stake", DecCoins{NewDecCoinFromDec("stake", NewDecWithPrec(4, 3))}, false},
+		{"5.04atom,0.004stake", DecCoins{
+			NewDecCoinFromDec("atom", NewDecWithPrec(504, 2)),
+			NewDecCoinFromDec("stake", NewDecWithPrec(4, 3)),
+		}, false},
+		{"0.004stake,5.04atom", DecCoins{
+			NewDecCoinFromDec("atom", NewDecWithPrec(504, 2)),
+			NewDecCoinFromDec("stake", NewDecWithPrec(4, 3)),
+		}, false},
+		{"1stake,2stake", nil, true},
+		{"0.00000000001stake", nil, true},
+		{"1.5", nil, true},
+		{"-1stake", nil, true},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseDecCoins(tc.input)
+		if tc.expectedErr {
+			require.Error(t, err, "expected error for test case #%d, input: %v", i, tc.input)
+		} else {
+			require.NoError(t, err, "unexpected error for test case #%d, input: %v", i, tc.input)
+			require.True(t, tc.expectedResult.IsEqual(res), "unexpected result for test case #%d, input: %v", i, tc.input)
+		}
+	}
+}
+
+func TestDecCoinsString(t *testing.T) {
+	testCases := []struct {
+		input    DecCoins
+		expected string
+	}{
+		{DecCoins{}, ""},
+		{
+			DecCoins{
+				NewDecCoinFromDec("atom", NewDecWithPrec(504, 2)),
+				NewDecCoinFromDec("stake", NewDecWithPrec(4, 3)),
+			},
+			"5.0400000000atom,0.0040000000stake",
+		},
+	}
+
+	for i, tc := range testCases {
+		out := tc.input.String()
+		require.Equal(t, tc.expected, out, "unexpected result for test case #%d, input: %v", i, tc.input)
+	}
+}
+
+func TestDecCoinsTruncateDecimal(t *testing.T) {
+	decCoinA := NewDecCoinFromDec("bar", MustNewDecFromStr("5.41"))
+	decCoinB := NewDecCoinFromDec("foo", MustNewDecFromStr("6.00"))
+
+	testCases := []struct {
+		input          DecCoins
+		truncatedCoins Coins
+		changeCoins    DecCoins
+	}{
+		{DecCoins{}, Coins(nil), DecCoins(nil)},
+		{
+			DecCoins{decCoinA, decCoinB},
+			Coins{NewInt64Coin(decCoinA.Denom, 5), NewInt64Coin(decCoinB.Denom, 6)},
+			DecCoins{NewDecCoinFromDec(decCoinA.Denom, MustNewDecFromStr("0.41"))},
+		},
+		{
+			DecCoins{decCoinB},
+			Coins{NewInt64Coin(decCoinB.Denom, 6)},
+			DecCoins(nil),
+		},
+	}
+
+	for i, tc := range testCases {
+		truncatedCoins, changeCoins := tc.input.TruncateDecimal()
+		require.True(t, tc.truncatedCoins.IsEqual(truncatedCoins),
+			"unexpected truncated coins; tc #%d, input: %s", i, tc.input,
+		)
+		require.True(t, tc.changeCoins.IsEqual(changeCoins),
+			"unexpected change coins; tc #%d, input: %s", i, tc.input,
+		)
+	}
+}
@@ -0,0 +1,82 @@
+package types
+
+import "fmt"
+
+// CodeType is the stable error code reported to JS callers.
+type CodeType string
+
+const (
+	CodeInvalidAddress  CodeType = "INVALID_ADDRESS"
+	CodeInvalidPubKey   CodeType = "INVALID_PUBKEY"
+	CodeInvalidMnemonic CodeType = "INVALID_MNEMONIC"
+	CodeInvalidCoins    CodeType = "INVALID_COIN"
+	CodeInvalidRequest  CodeType = "INVALID_REQUEST"
+	CodeTxDecode        CodeType = "TX_DECODE"
+	CodeKeyNotFound     CodeType = "KEY_NOT_FOUND"
+	CodeKeyExists       CodeType = "KEY_EXISTS"
+	CodeWrongPassphrase CodeType = "WRONG_PASSPHRASE"
+	CodeInternal        CodeType = "INTERNAL"
+)
+
+type Error interface {
+	error
+	Code() CodeType
+}
+
+type codedError struct {
+	code CodeType
+	msg  string
+}
+
+var _ Error = codedError{}
+
+func NewError(code CodeType, format string, args ...interface{}) Error {
+	return codedError{code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+func (err codedError) Error() string  { return err.msg }
+func (err codedError) Code() CodeType { return err.code }
+
+func ErrInvalidAddress(format string, args ...interface{}) Error {
+	return NewError(CodeInvalidAddress, format, args...)
+}
+
+func ErrInvalidPubKey(format string, args ...interface{}) Error {
+	return NewError(CodeInvalidPubKey, format, args...)
+}
+
+func ErrInvalidMnemonic(format string, args ...interface{}) Error {
+	return NewError(CodeInvalidMnemonic, format, args...)
+}
+
+func ErrInvalidCoins(format string, args ...interface{}) Error {
+	return NewError(CodeInvalidCoins, format, args...)
+}
+
+func ErrInvalidRequest(format string, args ...interface{}) Error {
+	return NewError(CodeInvalidRequest, format, args...)
+}
+
+func ErrTxDecode(format string, args ...interface{}) Error {
+	return NewError(CodeTxDecode, format, args...)
+}
+
+func ErrKeyNotFound(name string) Error {
+	return NewError(CodeKeyNotFound, "key %s not found", name)
+}
+
+func ErrKeyExists(name string) Error {
+	return NewError(CodeKeyExists, "key %s already exists", name)
+}
+
+func ErrWrongPassphrase(name string) Error {
+	return NewError(CodeWrongPassphrase, "wrong passphrase for key %s", name)
+}
+
+// CodeOf returns the code carried by err, or CodeInternal for plain errors.
+func CodeOf(err error) CodeType {
+	if err, ok := err.(Error); ok {
+		return err.Code()
+	}
+	return CodeInternal
+}
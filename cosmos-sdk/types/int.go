@@ -148,6 +148,11 @@ func (i Int) IsInt64() bool {
 	return i.i.IsInt64()
 }
 
+// IsNil returns true if Int is uninitialized
+func (i Int) IsNil() bool {
+	return i.i == nil
+}
+
 // IsZero returns true if Int is zero
 func (i Int) IsZero() bool {
 	return i.i.Sign() == 0
@@ -0,0 +1,186 @@
+package module
+
+import (
+	"bytes"
+
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Module is a set of messages and JS exports that an app registers with a
+// Registry.
+type Module interface {
+	// Name identifies the module and must be unique within a Registry.
+	Name() string
+
+	// RegisterCodec registers the module's messages and the types they use.
+	RegisterCodec(cdc *codec.Codec)
+
+	// Msgs returns a value of each of the module's message types. Their
+	// routes must not be used by another module.
+	Msgs() []types.Msg
+
+	// Exports returns the module's JS exports by name, see
+	// client.BindExport.
+	Exports() map[string]interface{}
+
+	// ValidateMsg checks one of the module's messages before buildAndSign
+	// signs it. ValidateBasic is left to the transaction, which runs it
+	// anyway. Modules embed MsgValidator for the checks that apply to every
+	// message.
+	ValidateMsg(msg types.Msg) error
+}
+
+// MsgValidator implements Module.ValidateMsg with the checks that apply to
+// every message: that it has signers and none of them is empty, as a
+// transaction without a signature for each can't be accepted.
+type MsgValidator struct{}
+
+func (MsgValidator) ValidateMsg(msg types.Msg) error {
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return types.ErrInvalidRequest("message %s has no signers", msg.Type())
+	}
+	for _, signer := range signers {
+		if signer.Empty() {
+			return types.ErrInvalidAddress("message %s has an empty signer", msg.Type())
+		}
+	}
+	return nil
+}
+
+// Registry holds the modules of an app and the sealed codec and JS export
+// table built from them.
+type Registry struct {
+	modules []Module
+	routes  map[string]Module
+	cdc     *codec.Codec
+	exports map[string]interface{}
+}
+
+// NewRegistry builds the codec and export table of modules. The codec is the
+// registry's own and only encodes and decodes transactions; messages marshal
+// their sign bytes with the codecs of their packages. It fails if two modules
+// share a name, a message route or an export, or if a module's message is not
+// registered by its RegisterCodec.
+func NewRegistry(modules ...Module) (*Registry, error) {
+	r := &Registry{
+		modules: modules,
+		routes:  make(map[string]Module),
+		exports: make(map[string]interface{}),
+	}
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+
+	names := make(map[string]bool)
+	for _, m := range modules {
+		if names[m.Name()] {
+			return nil, types.NewError(types.CodeInternal, "duplicate module %s", m.Name())
+		}
+		names[m.Name()] = true
+
+		m.RegisterCodec(cdc)
+	}
+
+	for _, m := range modules {
+		for _, msg := range m.Msgs() {
+			if other, ok := r.routes[msg.Route()]; ok && other.Name() != m.Name() {
+				return nil, types.NewError(types.CodeInternal, "modules %s and %s both use route %s", other.Name(), m.Name(), msg.Route())
+			}
+			r.routes[msg.Route()] = m
+
+			bz, err := cdc.MarshalJSON(msg)
+			if err != nil || !bytes.HasPrefix(bz, []byte(`{"type":`)) {
+				return nil, types.NewError(types.CodeInternal, "module %s does not register %T", m.Name(), msg)
+			}
+		}
+
+		for name, fn := range m.Exports() {
+			if err := r.export(name, fn); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := r.export("buildAndSign", r.BuildAndSignJSON); err != nil {
+		return nil, err
+	}
+
+	r.cdc = cdc.Seal()
+	return r, nil
+}
+
+// Codec returns the sealed codec of the registered modules.
+func (r *Registry) Codec() *codec.Codec {
+	return r.cdc
+}
+
+// Exports returns the JS exports of the registered modules by name, plus
+// buildAndSign, bound by client.BindExport to the registry's codec and to the
+// chain with prefixes cfg.
+func (r *Registry) Exports(cfg types.Bech32Config) map[string]interface{} {
+	exports := make(map[string]interface{}, len(r.exports))
+	for name, fn := range r.exports {
+		exports[name] = client.BindExport(fn, r.cdc, cfg)
+	}
+	return exports
+}
+
+// ValidateMsgs checks each of msgs with the module that owns its route.
+func (r *Registry) ValidateMsgs(msgs []types.Msg) error {
+	for _, msg := range msgs {
+		m, ok := r.routes[msg.Route()]
+		if !ok {
+			return types.ErrInvalidRequest("no module handles route %s", msg.Route())
+		}
+		if err := m.ValidateMsg(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildAndSignJSON is the JS export that signs any message of the registered
+// modules. msg is one amino JSON message {type, value}, an array of them or
+// the same as a JSON string, with addresses in the prefixes of cfg; options
+// and keyOrSeed are read as in tx.BuildAndSignFromJS.
+func (r *Registry) BuildAndSignJSON(cfg types.Bech32Config, msg, options, keyOrSeed *js.Object) string {
+	defer client.Recover()
+
+	msgJSON, err := client.JSONFromJS(msg, "message")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	var msgs []types.Msg
+	cfg.WithJSON(func() {
+		msgs, err = tx.DecodeMsgs(r.cdc, msgJSON)
+	})
+	if err != nil {
+		client.Throw(err)
+	}
+
+	if err := r.ValidateMsgs(msgs); err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(r.cdc, cfg, msgs, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+func (r *Registry) export(name string, fn interface{}) error {
+	if _, ok := r.exports[name]; ok {
+		return types.NewError(types.CodeInternal, "duplicate export %s", name)
+	}
+	r.exports[name] = fn
+	return nil
+}
@@ -18,6 +18,6 @@ type Tx interface {
 	ValidateBasic() error
 }
 
-type TxDecoder func(txBytes []byte) (Tx, err error)
+type TxDecoder func(txBytes []byte) (Tx, error)
 
 type TxEncoder func(tx Tx) ([]byte, error)
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"encoding/base64"
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+)
+
+// DecodeTx is the JS export of Decode for base64 input, such as the output of
+// sendCoins.
+func DecodeTx(cdc *codec.Codec, cfg types.Bech32Config, txBase64 string) string {
+	defer client.Recover()
+
+	txBytes, err := base64.StdEncoding.DecodeString(txBase64)
+	if err != nil {
+		client.Throw(types.ErrTxDecode("invalid base64: %s", err))
+	}
+
+	data, err := Decode(cdc, cfg, txBytes)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+type decodedTx struct {
+	Msgs       []types.Msg        `json:"msg"`
+	Fee        auth.StdFee        `json:"fee"`
+	Signatures []decodedSignature `json:"signatures"`
+	Memo       string             `json:"memo"`
+}
+
+type decodedSignature struct {
+	PubKey    string `json:"pub_key"`
+	Signature []byte `json:"signature"`
+}
+
+// Decode returns the amino encoded StdTx txBytes as amino JSON, with the
+// signers' public keys in bech32 and all prefixes those of cfg. cdc must have
+// the types of the transaction's messages registered.
+func Decode(cdc *codec.Codec, cfg types.Bech32Config, txBytes []byte) (string, error) {
+
+	tx, err := auth.DefaultTxDecoder(cdc)(txBytes)
+	if err != nil {
+		return "", err
+	}
+	stdTx := tx.(auth.StdTx)
+
+	out := decodedTx{
+		Msgs: stdTx.GetMsgs(),
+		Fee:  stdTx.Fee,
+		Memo: stdTx.GetMemo(),
+	}
+
+	for _, sig := range stdTx.GetSignatures() {
+		var pubKey string
+		if sig.PubKey != nil {
+			pubKey, err = types.PubKeyFromBytesWithConfig(cfg, sig.PubKey)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		out.Signatures = append(out.Signatures, decodedSignature{
+			PubKey:    pubKey,
+			Signature: sig.Signature,
+		})
+	}
+
+	var bz []byte
+	cfg.WithJSON(func() {
+		bz, err = cdc.MarshalJSON(out)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
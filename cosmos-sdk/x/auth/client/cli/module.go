@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+)
+
+// AppModule is the auth module, which signs, merges and decodes transactions.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "auth" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	auth.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return nil
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"signTx":          SignTx,
+		"mergeSignatures": MergeSignatures,
+		"signPartial":     SignPartial,
+		"combineMultisig": CombineMultisig,
+		"decodeTx":        DecodeTx,
+	}
+}
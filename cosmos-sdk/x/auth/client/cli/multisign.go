@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SignPartial is the JS export of PartialSign. accountNumber and sequence are
+// those of the multisig account.
+func SignPartial(cdc *codec.Codec, cfg types.Bech32Config, txJSON string, keyOrSeed, accountNumber, sequence *js.Object, chainID string) string {
+	defer client.Recover()
+
+	signer, err := keys.SignerFromJS(keyOrSeed)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	accNum, err := client.Uint64FromJS(accountNumber, "account_number")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	seq, err := client.Uint64FromJS(sequence, "sequence")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := PartialSign(cdc, txJSON, signer, txbuilder.NewBaseReq(accNum, seq, 0, chainID, "", "", "").WithBech32(cfg))
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// CombineMultisig is the JS export of Multisign; partialSigs is an array of
+// SignPartial results, and accountNumber, sequence and chainID are those they
+// were made with.
+func CombineMultisig(cdc *codec.Codec, cfg types.Bech32Config, txJSON string, partialSigs *js.Object, multisigPubKey string, accountNumber, sequence *js.Object, chainID string) string {
+	defer client.Recover()
+
+	elems, err := client.ArrayFromJS(partialSigs, "partial signatures")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	sigJSONs := make([]string, len(elems))
+	for i, elem := range elems {
+		sigJSONs[i] = elem.String()
+	}
+
+	accNum, err := client.Uint64FromJS(accountNumber, "account_number")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	seq, err := client.Uint64FromJS(sequence, "sequence")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := Multisign(cdc, txJSON, sigJSONs, multisigPubKey, txbuilder.NewBaseReq(accNum, seq, 0, chainID, "", "", "").WithBech32(cfg))
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// PartialSign returns the amino JSON signature of signer for the amino JSON
+// transaction txJSON, like `gaiacli tx sign --multisig --signature-only`.
+func PartialSign(cdc *codec.Codec, txJSON string, signer txbuilder.Signer, baseReq txbuilder.BaseReq) (string, error) {
+
+	stdTx, err := unmarshalTx(cdc, baseReq.Bech32, txJSON)
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := baseReq.MakeSignature(signer, stdTx)
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := codec.Cdc.MarshalJSON(sig)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// Multisign combines the partial signatures sigJSONs by keys of the bech32
+// multisig public key multisigPubKey into txJSON, like `gaiacli tx multisign`.
+// The signatures must be for the account number, sequence and chain ID of
+// baseReq.
+func Multisign(cdc *codec.Codec, txJSON string, sigJSONs []string, multisigPubKey string, baseReq txbuilder.BaseReq) (string, error) {
+
+	stdTx, err := unmarshalTx(cdc, baseReq.Bech32, txJSON)
+	if err != nil {
+		return "", err
+	}
+
+	pubKey, err := types.PubKeyFromBech32StringWithConfig(baseReq.Bech32, multisigPubKey)
+	if err != nil {
+		return "", err
+	}
+
+	sigs := make([]auth.StdSignature, len(sigJSONs))
+	for i, sigJSON := range sigJSONs {
+		if err := codec.Cdc.UnmarshalJSON([]byte(sigJSON), &sigs[i]); err != nil {
+			return "", types.ErrInvalidRequest("invalid signature: %s", err)
+		}
+	}
+
+	stdTx, err = baseReq.CombineMultisig(stdTx, pubKey, sigs)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalTx(cdc, baseReq.Bech32, stdTx)
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SignTx is the JS export of Sign. keyOrSeed is read with keys.SignerFromJS,
+// or with keys.SignerAccountsFromJS when it is an array.
+func SignTx(cdc *codec.Codec, cfg types.Bech32Config, txJSON string, keyOrSeed, accountNumber, sequence *js.Object, chainID string) string {
+	defer client.Recover()
+
+	accNum, err := client.Uint64FromJS(accountNumber, "account_number")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	seq, err := client.Uint64FromJS(sequence, "sequence")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	baseReq := txbuilder.NewBaseReq(accNum, seq, 0, chainID, "", "", "").WithBech32(cfg)
+
+	var signers []txbuilder.SignerAccount
+	if client.IsArray(keyOrSeed) {
+		signers, err = keys.SignerAccountsFromJS(keyOrSeed, baseReq)
+	} else {
+		var signer txbuilder.Signer
+		signer, err = keys.SignerFromJS(keyOrSeed)
+		signers = []txbuilder.SignerAccount{txbuilder.NewSignerAccount(signer, accNum, seq)}
+	}
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := SignMulti(cdc, txJSON, signers, baseReq)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// MergeSignatures is the JS export of Merge.
+func MergeSignatures(cdc *codec.Codec, cfg types.Bech32Config, txJSONs *js.Object) string {
+	defer client.Recover()
+
+	elems, err := client.ArrayFromJS(txJSONs, "transactions")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	txs := make([]string, len(elems))
+	for i, elem := range elems {
+		txs[i] = elem.String()
+	}
+
+	data, err := Merge(cdc, cfg, txs)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// Sign adds the signature of signer to the amino JSON transaction txJSON, like
+// `gaiacli tx sign`. Only the account number, sequence and chain ID of baseReq
+// are used.
+func Sign(cdc *codec.Codec, txJSON string, signer txbuilder.Signer, baseReq txbuilder.BaseReq) (string, error) {
+	signers := []txbuilder.SignerAccount{txbuilder.NewSignerAccount(signer, baseReq.AccountNumber, baseReq.Sequence)}
+	return SignMulti(cdc, txJSON, signers, baseReq)
+}
+
+// SignMulti is Sign with several signers, each with its own account number and
+// sequence.
+func SignMulti(cdc *codec.Codec, txJSON string, signers []txbuilder.SignerAccount, baseReq txbuilder.BaseReq) (string, error) {
+
+	stdTx, err := unmarshalTx(cdc, baseReq.Bech32, txJSON)
+	if err != nil {
+		return "", err
+	}
+
+	stdTx, err = baseReq.SignStdTxMulti(signers, stdTx)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalTx(cdc, baseReq.Bech32, stdTx)
+}
+
+// Merge combines the signatures of amino JSON transactions that differ only in
+// their signatures, see txbuilder.MergeSignatures. Addresses are in the
+// prefixes of cfg.
+func Merge(cdc *codec.Codec, cfg types.Bech32Config, txJSONs []string) (string, error) {
+
+	txs := make([]auth.StdTx, len(txJSONs))
+	for i, txJSON := range txJSONs {
+		stdTx, err := unmarshalTx(cdc, cfg, txJSON)
+		if err != nil {
+			return "", err
+		}
+		txs[i] = stdTx
+	}
+
+	stdTx, err := txbuilder.MergeSignatures(txs...)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalTx(cdc, cfg, stdTx)
+}
+
+func unmarshalTx(cdc *codec.Codec, cfg types.Bech32Config, txJSON string) (auth.StdTx, error) {
+	var stdTx auth.StdTx
+	var err error
+	cfg.WithJSON(func() {
+		err = cdc.UnmarshalJSON([]byte(txJSON), &stdTx)
+	})
+	if err != nil {
+		return auth.StdTx{}, types.ErrInvalidRequest("invalid transaction: %s", err)
+	}
+	return stdTx, nil
+}
+
+func marshalTx(cdc *codec.Codec, cfg types.Bech32Config, stdTx auth.StdTx) (string, error) {
+	var bz []byte
+	var err error
+	cfg.WithJSON(func() {
+		bz, err = cdc.MarshalJSON(stdTx)
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
@@ -0,0 +1,92 @@
+package txbuilder
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+)
+
+// Signer signs the bytes of a StdSignMsg.
+type Signer interface {
+	Sign(signBytes []byte) (auth.StdSignature, error)
+}
+
+// SeedSigner derives its key from a mnemonic each time it signs.
+type SeedSigner struct {
+	Mnemonic        string
+	BIP39Passphrase string
+	HDPath          keybase.HDPath
+}
+
+var _ Signer = SeedSigner{}
+
+func NewSeedSigner(mnemonic, bip39Passphrase string, path keybase.HDPath) SeedSigner {
+	return SeedSigner{
+		Mnemonic:        mnemonic,
+		BIP39Passphrase: bip39Passphrase,
+		HDPath:          path,
+	}
+}
+
+func (signer SeedSigner) Sign(signBytes []byte) (auth.StdSignature, error) {
+
+	privKey, err := keybase.DerivePrivKey(signer.Mnemonic, signer.BIP39Passphrase, signer.HDPath)
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	sigBytes, err := privKey.Sign(signBytes)
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	return auth.StdSignature{
+		PubKey:    privKey.PubKey(),
+		Signature: sigBytes,
+	}, nil
+}
+
+// KeybaseSigner signs with a key stored in a keybase.
+type KeybaseSigner struct {
+	Keybase    keybase.Keybase
+	Name       string
+	Passphrase string
+}
+
+var _ Signer = KeybaseSigner{}
+
+func NewKeybaseSigner(kb keybase.Keybase, name, passphrase string) KeybaseSigner {
+	return KeybaseSigner{
+		Keybase:    kb,
+		Name:       name,
+		Passphrase: passphrase,
+	}
+}
+
+func (signer KeybaseSigner) Sign(signBytes []byte) (auth.StdSignature, error) {
+
+	sigBytes, pubKey, err := signer.Keybase.Sign(signer.Name, signer.Passphrase, signBytes)
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	return auth.StdSignature{
+		PubKey:    pubKey,
+		Signature: sigBytes,
+	}, nil
+}
+
+// SignerAccount is a Signer together with the account number and sequence of
+// the address it signs for.
+type SignerAccount struct {
+	Signer        Signer
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+func NewSignerAccount(signer Signer, accountNumber, sequence uint64) SignerAccount {
+	return SignerAccount{
+		Signer:        signer,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	}
+}
@@ -12,8 +12,14 @@ type StdSignMsg struct {
 	Fee           auth.StdFee `json:"fee"`
 	Msgs          []types.Msg `json:"msgs"`
 	Memo          string      `json:"memo"`
+
+	// Bech32 holds the prefixes the addresses in Msgs are signed with.
+	Bech32 types.Bech32Config `json:"-"`
 }
 
-func (msg StdSignMsg) Bytes() []byte {
-	return auth.StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+func (msg StdSignMsg) Bytes() (bz []byte) {
+	msg.Bech32.WithJSON(func() {
+		bz = auth.StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	})
+	return bz
 }
@@ -1,31 +1,43 @@
 package txbuilder
 
 import (
-	"fmt"
+	"bytes"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
 	"github.com/baymax19/js2go/cosmos-sdk/types"
 	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
-	"github.com/cosmos/go-bip39"
-	"github.com/pkg/errors"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"math/big"
 	"strings"
 )
 
 const (
-	defaultBIP39Passphrase = ""
+	DefaultGas = 200000
 )
 
+// BaseReq holds the fields every transaction needs besides its messages.
+// ChainID is required; a zero Gas falls back to DefaultGas, and an empty or
+// zero Fee or an empty Memo leaves them out of the transaction. Fee lists fixed
+// fee coins, while GasPrices, such as "0.025uatom,0.1stake", derives the fee
+// from Gas; at most one of them may be set. HDPath selects the key derived from
+// the signing mnemonic and defaults to keybase.DefaultHDPath; BIP39Passphrase
+// is the mnemonic's passphrase and defaults to "". Bech32 holds the chain's
+// prefixes, which addresses are read with and signed in.
 type BaseReq struct {
-	TxEncoder     types.TxEncoder
-	AccountNumber uint64 `json:"account_number"`
-	Sequence      uint64 `json:"sequence"`
-	Gas           uint64 `json:"gas"`
-	ChainID       string `json:"chain_id"`
-	Memo          string `json:"memo"`
-	Fee           string `json:"fee"`
+	TxEncoder       types.TxEncoder
+	AccountNumber   uint64 `json:"account_number"`
+	Sequence        uint64 `json:"sequence"`
+	Gas             uint64 `json:"gas"`
+	ChainID         string `json:"chain_id"`
+	Memo            string `json:"memo"`
+	Fee             string `json:"fee"`
+	GasPrices       string `json:"gas_prices"`
+	HDPath          string `json:"hd_path"`
+	BIP39Passphrase string `json:"bip39_passphrase"`
+	Bech32          types.Bech32Config
 }
 
-func NewBaseReq(accountNumber, sequence, gas uint64, chainID, memo, fee string) *BaseReq {
+func NewBaseReq(accountNumber, sequence, gas uint64, chainID, memo, fee, gasPrices string) *BaseReq {
 	return &BaseReq{
 		AccountNumber: accountNumber,
 		Sequence:      sequence,
@@ -33,6 +45,7 @@ func NewBaseReq(accountNumber, sequence, gas uint64, chainID, memo, fee string)
 		ChainID:       chainID,
 		Memo:          memo,
 		Fee:           fee,
+		GasPrices:     gasPrices,
 	}
 }
 
@@ -41,6 +54,21 @@ func (bldr BaseReq) WithTxEncoder(txEncoder types.TxEncoder) BaseReq {
 	return bldr
 }
 
+func (bldr BaseReq) WithHDPath(path keybase.HDPath) BaseReq {
+	bldr.HDPath = path.String()
+	return bldr
+}
+
+func (bldr BaseReq) WithBIP39Passphrase(bip39Passphrase string) BaseReq {
+	bldr.BIP39Passphrase = bip39Passphrase
+	return bldr
+}
+
+func (bldr BaseReq) WithBech32(cfg types.Bech32Config) BaseReq {
+	bldr.Bech32 = cfg
+	return bldr
+}
+
 func (bldr BaseReq) BuildAndSign(seed string, msgs []types.Msg) ([]byte, error) {
 
 	msg, err := bldr.Build(msgs)
@@ -50,71 +78,384 @@ func (bldr BaseReq) BuildAndSign(seed string, msgs []types.Msg) ([]byte, error)
 
 	return bldr.MakeSignUsingSeed(seed, msg)
 }
+
+// BuildAndSignWithSigner signs with any Signer.
+func (bldr BaseReq) BuildAndSignWithSigner(signer Signer, msgs []types.Msg) ([]byte, error) {
+
+	msg, err := bldr.Build(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.MakeSign(signer, msg)
+}
+
+// BuildAndSignMulti signs msgs with every signer, for transactions with more
+// than one signer address. signers must be in the order of StdTx.GetSigners.
+func (bldr BaseReq) BuildAndSignMulti(signers []SignerAccount, msgs []types.Msg) ([]byte, error) {
+
+	msg, err := bldr.Build(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.MakeMultiSign(signers, msg)
+}
+
+// BuildAndSignWithKeybase signs with the key stored in kb under name instead
+// of deriving it from a mnemonic.
+func (bldr BaseReq) BuildAndSignWithKeybase(kb keybase.Keybase, name, passphrase string, msgs []types.Msg) ([]byte, error) {
+
+	msg, err := bldr.Build(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.MakeSignUsingKeybase(kb, name, passphrase, msg)
+}
+
 func (bldr BaseReq) Build(msgs []types.Msg) (StdSignMsg, error) {
 	chainID := bldr.ChainID
 	if chainID == "" {
-		return StdSignMsg{}, errors.Errorf("chain ID required but not specified")
+		return StdSignMsg{}, types.ErrInvalidRequest("chain ID required but not specified")
 	}
 
-	fee := types.Coin{}
-	if bldr.Fee != "" {
-		parsedFee, err := types.ParseCoin(bldr.Fee)
-		if err != nil {
-			return StdSignMsg{}, err
-		}
+	gas := bldr.Gas
+	if gas == 0 {
+		gas = DefaultGas
+	}
 
-		fee = parsedFee
+	fees, err := bldr.fees(gas)
+	if err != nil {
+		return StdSignMsg{}, err
 	}
-	return StdSignMsg{
+
+	msg := StdSignMsg{
 		ChainID:       bldr.ChainID,
 		AccountNumber: bldr.AccountNumber,
 		Sequence:      bldr.Sequence,
 		Memo:          bldr.Memo,
 		Msgs:          msgs,
-		Fee:           auth.NewStdFee(bldr.Gas, fee),
-	}, nil
+		Bech32:        bldr.Bech32,
+		Fee:           auth.NewStdFee(gas, fees...),
+	}
+
+	if err := auth.NewStdTx(msg.Msgs, msg.Fee, nil, msg.Memo).ValidateContent(); err != nil {
+		return StdSignMsg{}, err
+	}
+
+	return msg, nil
+}
+
+// fees returns Fee, or ceil(gas * price) for each of GasPrices. Zero coins are
+// left out of both, so a fee of "0stake" means no fee.
+func (bldr BaseReq) fees(gas uint64) (types.Coins, error) {
+	feeStr, err := withoutZeroCoins(bldr.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPricesStr, err := withoutZeroCoins(bldr.GasPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	if feeStr != "" && gasPricesStr != "" {
+		return nil, types.ErrInvalidRequest("cannot provide both fees and gas prices")
+	}
+
+	if gasPricesStr == "" {
+		return types.ParseCoins(feeStr)
+	}
+
+	gasPrices, err := types.ParseDecCoins(gasPricesStr)
+	if err != nil {
+		return nil, err
+	}
+
+	gasDec := types.NewDecFromBigInt(new(big.Int).SetUint64(gas))
+
+	var fees types.Coins
+	for _, gasPrice := range gasPrices {
+		fee := types.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(gasDec).Ceil().TruncateInt())
+		if !fee.IsZero() {
+			fees = append(fees, fee)
+		}
+	}
+	return fees, nil
+}
+
+// withoutZeroCoins drops the zero amounts from a list of coins such as
+// "0stake,10uatom", which ParseCoins and ParseDecCoins would reject.
+func withoutZeroCoins(coinsStr string) (string, error) {
+	if strings.TrimSpace(coinsStr) == "" {
+		return "", nil
+	}
+
+	var coinStrs []string
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		coin, err := types.ParseDecCoin(coinStr)
+		if err != nil {
+			return "", err
+		}
+		if !coin.IsZero() {
+			coinStrs = append(coinStrs, coinStr)
+		}
+	}
+	return strings.Join(coinStrs, ","), nil
 }
 
 func (bldr BaseReq) MakeSignUsingSeed(mnemonic string, msg StdSignMsg) ([]byte, error) {
-	sign, err := makeSign(mnemonic, msg)
+	path, err := bldr.ParseHDPath()
 	if err != nil {
 		return nil, err
 	}
 
-	stdTx := auth.NewStdTx(msg.Msgs, msg.Fee, []auth.StdSignature{sign}, msg.Memo)
+	return bldr.MakeSign(NewSeedSigner(mnemonic, bldr.BIP39Passphrase, path), msg)
+}
+
+func (bldr BaseReq) MakeSignUsingKeybase(kb keybase.Keybase, name, passphrase string, msg StdSignMsg) ([]byte, error) {
+	return bldr.MakeSign(NewKeybaseSigner(kb, name, passphrase), msg)
+}
+
+// MakeSign signs msg with signer, which must sign for the only signer address
+// of msg.
+func (bldr BaseReq) MakeSign(signer Signer, msg StdSignMsg) ([]byte, error) {
+	stdTx := auth.NewStdTx(msg.Msgs, msg.Fee, nil, msg.Memo)
+
+	addrs := stdTx.GetSigners()
+	if len(addrs) != 1 {
+		return nil, types.ErrInvalidRequest("expected 1 signer, got %d", len(addrs))
+	}
+
+	sign, err := signer.Sign(msg.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(sign.PubKey.Address(), addrs[0]) {
+		return nil, types.ErrInvalidRequest("key signs for %s, expected %s",
+			types.AccAddress(sign.PubKey.Address()), addrs[0])
+	}
+
+	stdTx.Signatures = []auth.StdSignature{sign}
+	if err := stdTx.ValidateBasic(); err != nil {
+		return nil, err
+	}
 
 	return bldr.TxEncoder(stdTx)
 }
 
-func makeSign(mnemonic string, msg StdSignMsg) (auth.StdSignature, error) {
+// MakeMultiSign signs msg once per signer, each with its own account number
+// and sequence in place of those of msg.
+func (bldr BaseReq) MakeMultiSign(signers []SignerAccount, msg StdSignMsg) ([]byte, error) {
+	stdTx := auth.NewStdTx(msg.Msgs, msg.Fee, nil, msg.Memo)
 
-	words := strings.Split(mnemonic, " ")
+	addrs := stdTx.GetSigners()
+	if len(signers) != len(addrs) {
+		return nil, types.ErrInvalidRequest("expected %d signers, got %d", len(addrs), len(signers))
+	}
 
-	if len(words) != 12 && len(words) != 24 {
-		err := fmt.Errorf("recovering only works with 12 word (fundraiser) or 24 word mnemonics, got: %v words", len(words))
-		return auth.StdSignature{}, err
+	for i, signer := range signers {
+		msg.AccountNumber, msg.Sequence = signer.AccountNumber, signer.Sequence
+
+		sign, err := signer.Signer.Sign(msg.Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(sign.PubKey.Address(), addrs[i]) {
+			return nil, types.ErrInvalidRequest("signer %d signs for %s, expected %s",
+				i, types.AccAddress(sign.PubKey.Address()), addrs[i])
+		}
+
+		stdTx.Signatures = append(stdTx.Signatures, sign)
+	}
+
+	if err := stdTx.ValidateBasic(); err != nil {
+		return nil, err
 	}
 
-	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, defaultBIP39Passphrase)
+	return bldr.TxEncoder(stdTx)
+}
+
+// BuildUnsigned returns the transaction without signatures, as printed by
+// `gaiacli tx send --generate-only`.
+func (bldr BaseReq) BuildUnsigned(msgs []types.Msg) (auth.StdTx, error) {
+
+	msg, err := bldr.Build(msgs)
 	if err != nil {
-		return auth.StdSignature{}, err
+		return auth.StdTx{}, err
 	}
 
-	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+	return auth.NewStdTx(msg.Msgs, msg.Fee, nil, msg.Memo), nil
+}
+
+// SignStdTx signs stdTx for the account number, sequence and chain ID of bldr.
+// When appendSig is set the signature is merged into the existing ones, as in
+// MergeSignatures; otherwise it replaces them, like `gaiacli tx sign`.
+func (bldr BaseReq) SignStdTx(signer Signer, stdTx auth.StdTx, appendSig bool) (auth.StdTx, error) {
 
-	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, hd.FullFundraiserPath)
+	sign, err := bldr.MakeSignature(signer, stdTx)
 	if err != nil {
-		panic(err)
+		return auth.StdTx{}, err
 	}
-	privKey := secp256k1.PrivKeySecp256k1(derivedPriv)
 
-	sigBytes, err := privKey.Sign(msg.Bytes())
+	signed := auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []auth.StdSignature{sign}, stdTx.GetMemo())
+	if !appendSig {
+		return MergeSignatures(signed)
+	}
+	return MergeSignatures(stdTx, signed)
+}
+
+// MakeSignature returns the signature of stdTx for the account number,
+// sequence and chain ID of bldr without adding it to stdTx, like
+// `gaiacli tx sign --signature-only`.
+func (bldr BaseReq) MakeSignature(signer Signer, stdTx auth.StdTx) (auth.StdSignature, error) {
+
+	msg, err := bldr.signMsg(stdTx)
 	if err != nil {
-		return auth.StdSignature{}, nil
+		return auth.StdSignature{}, err
 	}
 
-	return auth.StdSignature{
-		PubKey:    secp256k1.PrivKeySecp256k1(derivedPriv).PubKey(),
-		Signature: sigBytes,
+	return signer.Sign(msg.Bytes())
+}
+
+// signMsg returns what signers of stdTx sign for the account number, sequence
+// and chain ID of bldr.
+func (bldr BaseReq) signMsg(stdTx auth.StdTx) (StdSignMsg, error) {
+	if bldr.ChainID == "" {
+		return StdSignMsg{}, types.ErrInvalidRequest("chain ID required but not specified")
+	}
+
+	return StdSignMsg{
+		ChainID:       bldr.ChainID,
+		AccountNumber: bldr.AccountNumber,
+		Sequence:      bldr.Sequence,
+		Fee:           stdTx.Fee,
+		Msgs:          stdTx.GetMsgs(),
+		Memo:          stdTx.GetMemo(),
+		Bech32:        bldr.Bech32,
 	}, nil
 }
+
+// SignStdTxMulti signs stdTx with every signer, each with its own account
+// number and sequence, and merges the signatures into stdTx.
+func (bldr BaseReq) SignStdTxMulti(signers []SignerAccount, stdTx auth.StdTx) (auth.StdTx, error) {
+	for _, signer := range signers {
+		bldr.AccountNumber, bldr.Sequence = signer.AccountNumber, signer.Sequence
+
+		var err error
+		stdTx, err = bldr.SignStdTx(signer.Signer, stdTx, true)
+		if err != nil {
+			return auth.StdTx{}, err
+		}
+	}
+	return stdTx, nil
+}
+
+// MergeSignatures combines the signatures of copies of one transaction signed
+// separately, such as on different devices. The result holds one signature per
+// signer in the order of StdTx.GetSigners, and a later signature by the same
+// signer replaces an earlier one. It fails if the copies differ in messages,
+// fee or memo, or a signature is not by one of the signers.
+func MergeSignatures(txs ...auth.StdTx) (auth.StdTx, error) {
+	if len(txs) == 0 {
+		return auth.StdTx{}, types.ErrInvalidRequest("no transactions to merge")
+	}
+
+	tx := txs[0]
+	unsigned := auth.StdSignBytes("", 0, 0, tx.Fee, tx.GetMsgs(), tx.GetMemo())
+	signers := tx.GetSigners()
+
+	sigs := make(map[string]auth.StdSignature)
+	for _, other := range txs {
+		if !bytes.Equal(auth.StdSignBytes("", 0, 0, other.Fee, other.GetMsgs(), other.GetMemo()), unsigned) {
+			return auth.StdTx{}, types.ErrInvalidRequest("transactions differ in messages, fee or memo")
+		}
+
+		for _, sig := range other.GetSignatures() {
+			if sig.PubKey == nil {
+				return auth.StdTx{}, types.ErrInvalidRequest("signature without public key")
+			}
+
+			addr := types.AccAddress(sig.PubKey.Address())
+			if !isSigner(signers, addr) {
+				return auth.StdTx{}, types.ErrInvalidRequest("%s is not a signer of the transaction", addr)
+			}
+			sigs[addr.String()] = sig
+		}
+	}
+
+	var ordered []auth.StdSignature
+	for _, addr := range signers {
+		if sig, ok := sigs[addr.String()]; ok {
+			ordered = append(ordered, sig)
+		}
+	}
+
+	return auth.NewStdTx(tx.GetMsgs(), tx.Fee, ordered, tx.GetMemo()), nil
+}
+
+// CombineMultisig combines signatures made with MakeSignature by keys of the
+// multisig public key pubKey into one signature of the multisig account, and
+// merges it into stdTx like `gaiacli tx multisign`. Like gaiacli, it checks
+// each signature against the account number, sequence and chain ID of bldr.
+func (bldr BaseReq) CombineMultisig(stdTx auth.StdTx, pubKey crypto.PubKey, sigs []auth.StdSignature) (auth.StdTx, error) {
+	multisigKey, ok := pubKey.(*multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return auth.StdTx{}, types.ErrInvalidPubKey("not a multisig public key")
+	}
+
+	msg, err := bldr.signMsg(stdTx)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+	signBytes := msg.Bytes()
+
+	multiSig := multisig.NewMultisig(len(multisigKey.PubKeys))
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			return auth.StdTx{}, types.ErrInvalidRequest("signature without public key")
+		}
+
+		addr := types.AccAddress(sig.PubKey.Address())
+		if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+			return auth.StdTx{}, types.ErrInvalidRequest("signature by %s does not match the transaction, account number, sequence or chain ID", addr)
+		}
+
+		err := multiSig.AddSignatureFromPubKey(sig.Signature, sig.PubKey, multisigKey.PubKeys)
+		if err != nil {
+			return auth.StdTx{}, types.ErrInvalidPubKey("%s is not a key of the multisig account", addr)
+		}
+	}
+
+	if len(multiSig.Sigs) < int(multisigKey.K) {
+		return auth.StdTx{}, types.ErrInvalidRequest("%d signatures required, got %d", multisigKey.K, len(multiSig.Sigs))
+	}
+
+	sign := auth.StdSignature{
+		PubKey:    multisigKey,
+		Signature: multiSig.Marshal(),
+	}
+	signed := auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []auth.StdSignature{sign}, stdTx.GetMemo())
+
+	return MergeSignatures(stdTx, signed)
+}
+
+func isSigner(signers []types.AccAddress, addr types.AccAddress) bool {
+	for _, signer := range signers {
+		if signer.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseHDPath returns HDPath parsed, or keybase.DefaultHDPath when it is empty.
+func (bldr BaseReq) ParseHDPath() (keybase.HDPath, error) {
+	if bldr.HDPath == "" {
+		return keybase.DefaultHDPath, nil
+	}
+	return keybase.ParseHDPath(bldr.HDPath)
+}
@@ -0,0 +1,68 @@
+package txbuilder
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"testing"
+)
+
+const mnemonic = "sound coral chimney claim humor peasant reward vanish desk trouble army door shallow insect fence typical ice tonight change dust reduce bracket ancient embark"
+
+func TestCombineMultisig(t *testing.T) {
+	var pubKeys []crypto.PubKey
+	var signers []Signer
+	for i := uint32(0); i < 3; i++ {
+		path, err := keybase.NewHDPath(118, 0, i)
+		require.NoError(t, err)
+
+		privKey, err := keybase.DerivePrivKey(mnemonic, "", path)
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey())
+		signers = append(signers, NewSeedSigner(mnemonic, "", path))
+	}
+
+	multisigKey, err := keybase.NewMultisigPubKey(2, pubKeys)
+	require.NoError(t, err)
+
+	// The bech32 form of a multisig key is longer than the 90 characters
+	// BIP 173 allows, and must still read back.
+	bech32Key, err := types.PubKeyFromBytes(multisigKey)
+	require.NoError(t, err)
+	require.True(t, len(bech32Key) > 90)
+
+	pubKey, err := types.PubKeyFromBech32String(bech32Key)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(multisigKey))
+
+	bldr := *NewBaseReq(4, 2, 0, "test-chain", "", "", "")
+	msg := bank.CreateMsg(types.AccAddress(multisigKey.Address()), types.AccAddress(pubKeys[0].Address()),
+		types.Coins{types.NewInt64Coin("stake", 1)})
+	stdTx, err := bldr.BuildUnsigned([]types.Msg{msg})
+	require.NoError(t, err)
+
+	sig0, err := bldr.MakeSignature(signers[0], stdTx)
+	require.NoError(t, err)
+	sig2, err := bldr.MakeSignature(signers[2], stdTx)
+	require.NoError(t, err)
+
+	_, err = bldr.CombineMultisig(stdTx, pubKey, []auth.StdSignature{sig0})
+	require.Error(t, err, "one signature is below the threshold")
+
+	signed, err := bldr.CombineMultisig(stdTx, pubKey, []auth.StdSignature{sig2, sig0})
+	require.NoError(t, err)
+	require.NoError(t, signed.ValidateBasic())
+	require.Len(t, signed.Signatures, 1)
+
+	signMsg, err := bldr.signMsg(stdTx)
+	require.NoError(t, err)
+	require.True(t, signed.Signatures[0].PubKey.VerifyBytes(signMsg.Bytes(), signed.Signatures[0].Signature))
+
+	other := *NewBaseReq(4, 3, 0, "test-chain", "", "", "")
+	_, err = other.CombineMultisig(stdTx, pubKey, []auth.StdSignature{sig2, sig0})
+	require.Error(t, err, "signatures are for another sequence")
+}
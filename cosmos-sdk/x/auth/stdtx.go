@@ -11,6 +11,8 @@ var (
 	_ sdk.Tx = (*StdTx)(nil)
 
 	maxGasWanted = uint64((1 << 63) - 1)
+
+	maxMemoCharacters = 100
 )
 
 type StdTx struct {
@@ -29,8 +31,48 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
-func (tx StdTx) GetMsgs() []sdk.Msg   { return tx.Msgs }
-func (tx StdTx) ValidateBasic() error { return nil }
+func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
+
+// ValidateBasic runs the stateless checks the chain applies before the ante
+// handler, including that there is one signature per signer.
+func (tx StdTx) ValidateBasic() error {
+	if err := tx.ValidateContent(); err != nil {
+		return err
+	}
+
+	sigs := tx.GetSignatures()
+	if len(sigs) == 0 {
+		return sdk.ErrInvalidRequest("no signers")
+	}
+	if len(sigs) != len(tx.GetSigners()) {
+		return sdk.ErrInvalidRequest("wrong number of signers; expected %d, got %d", len(tx.GetSigners()), len(sigs))
+	}
+	return nil
+}
+
+// ValidateContent runs the checks of ValidateBasic that don't depend on the
+// signatures, so a transaction can be checked before it is signed.
+func (tx StdTx) ValidateContent() error {
+	if len(tx.GetMsgs()) == 0 {
+		return sdk.ErrInvalidRequest("must contain at least one message")
+	}
+	for _, msg := range tx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if tx.Fee.Gas > maxGasWanted {
+		return sdk.ErrInvalidRequest("invalid gas supplied; %d > %d", tx.Fee.Gas, maxGasWanted)
+	}
+	if !tx.Fee.Amount.IsNotNegative() {
+		return sdk.ErrInvalidCoins("invalid fee %s amount provided", tx.Fee.Amount)
+	}
+	if len(tx.GetMemo()) > maxMemoCharacters {
+		return sdk.ErrInvalidRequest("maximum number of characters is %d but received %d characters", maxMemoCharacters, len(tx.GetMemo()))
+	}
+	return nil
+}
 
 func (tx StdTx) GetSigners() []sdk.AccAddress {
 	seen := map[string]bool{}
@@ -110,3 +152,17 @@ func DefaultTxEncoder(cdc *codec.Codec) sdk.TxEncoder {
 		return cdc.MarshalBinaryLengthPrefixed(tx)
 	}
 }
+
+func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if len(txBytes) == 0 {
+			return nil, sdk.ErrTxDecode("txBytes are empty")
+		}
+
+		var tx StdTx
+		if err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx); err != nil {
+			return nil, sdk.ErrTxDecode("failed to decode tx: %s", err)
+		}
+		return tx, nil
+	}
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// GenerateSendTx is the JS export of GenerateSend; options are read like in
+// SendCoins.
+func GenerateSendTx(cdc *codec.Codec, cfg types.Bech32Config, from, to, amount string, options *js.Object) string {
+	defer client.Recover()
+
+	baseReq, err := client.BaseReqFromJS(cfg, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := GenerateSend(cdc, from, to, amount, baseReq)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// GenerateSend returns an unsigned MsgSend transaction as amino JSON, like
+// `gaiacli tx send --generate-only`. Addresses are in the prefixes of
+// baseReq.Bech32.
+func GenerateSend(cdc *codec.Codec, from, to, amount string, baseReq txbuilder.BaseReq) (string, error) {
+
+	fromAddr, err := types.AccAddressFromBech32WithConfig(baseReq.Bech32, from)
+	if err != nil {
+		return "", err
+	}
+
+	toAddr, err := types.AccAddressFromBech32WithConfig(baseReq.Bech32, to)
+	if err != nil {
+		return "", err
+	}
+
+	coins, err := types.ParseCoins(amount)
+	if err != nil {
+		return "", err
+	}
+
+	msg := bank.CreateMsg(fromAddr, toAddr, coins)
+
+	stdTx, err := baseReq.BuildUnsigned([]types.Msg{msg})
+	if err != nil {
+		return "", err
+	}
+
+	var bz []byte
+	baseReq.Bech32.WithJSON(func() {
+		bz, err = cdc.MarshalJSON(stdTx)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
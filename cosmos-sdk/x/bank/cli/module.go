@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
+)
+
+// AppModule is the bank module.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "bank" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	bank.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return []types.Msg{bank.MsgSend{}}
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"sendCoins":        SendCoins,
+		"sendCoinsWithKey": SendCoinsWithKey,
+		"multiSend":        MultiSendCoins,
+		"generateSendTx":   GenerateSendTx,
+	}
+}
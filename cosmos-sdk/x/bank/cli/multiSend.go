@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"encoding/base64"
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// MultiSendCoins is the JS export of MultiSend. msg is
+// {inputs: [{address, coins}], outputs: [{address, coins}]} with coins such as
+// "10stake,1uatom"; signers are read with keys.SignerAccountsFromJS.
+func MultiSendCoins(cdc *codec.Codec, cfg types.Bech32Config, msg, signers, options *js.Object) string {
+	defer client.Recover()
+
+	baseReq, err := client.BaseReqFromJS(cfg, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msgSend, err := msgSendFromJS(cfg, msg)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	signerAccounts, err := keys.SignerAccountsFromJS(signers, baseReq)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := MultiSend(cdc, msgSend, signerAccounts, baseReq)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// MultiSend signs a MsgSend with several inputs and returns the base64 encoded
+// transaction. signers must follow the order of the inputs' addresses.
+func MultiSend(cdc *codec.Codec, msg bank.MsgSend, signers []txbuilder.SignerAccount, baseReq txbuilder.BaseReq) (string, error) {
+	baseReq = baseReq.WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+	txBytes, err := baseReq.BuildAndSignMulti(signers, []types.Msg{msg})
+	if err != nil {
+		return "", err
+	}
+
+	data := base64.StdEncoding.EncodeToString(txBytes)
+	return data, nil
+}
+
+func msgSendFromJS(cfg types.Bech32Config, value *js.Object) (bank.MsgSend, error) {
+	if client.IsUndefined(value) {
+		return bank.MsgSend{}, types.ErrInvalidRequest("msg required but not specified")
+	}
+
+	inputs, err := ioFromJS(cfg, value.Get("inputs"), "inputs")
+	if err != nil {
+		return bank.MsgSend{}, err
+	}
+
+	outputs, err := ioFromJS(cfg, value.Get("outputs"), "outputs")
+	if err != nil {
+		return bank.MsgSend{}, err
+	}
+
+	var msg bank.MsgSend
+	for _, in := range inputs {
+		msg.Inputs = append(msg.Inputs, bank.Input(in))
+	}
+	for _, out := range outputs {
+		msg.Outputs = append(msg.Outputs, bank.Output(out))
+	}
+	return msg, nil
+}
+
+// ioFromJS reads a list of inputs or outputs, which share their fields.
+func ioFromJS(cfg types.Bech32Config, value *js.Object, name string) ([]bank.Output, error) {
+	elems, err := client.ArrayFromJS(value, name)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]bank.Output, len(elems))
+	for i, elem := range elems {
+		addr, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(elem, "address"))
+		if err != nil {
+			return nil, err
+		}
+
+		coins, err := types.ParseCoins(client.StringOption(elem, "coins"))
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[i] = bank.NewOutput(addr, coins)
+	}
+	return outputs, nil
+}
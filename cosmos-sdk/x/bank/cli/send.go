@@ -2,38 +2,59 @@ package cli
 
 import (
 	"encoding/base64"
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
 	"github.com/baymax19/js2go/cosmos-sdk/types"
 	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
 	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
 	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
-	jscodec "github.com/baymax19/js2go/types"
+	"github.com/gopherjs/gopherjs/js"
 )
 
-func SendCoins(from, to, amount, seed string) string {
+// SendCoins is the JS export; options is read with client.BaseReqFromJS.
+func SendCoins(cdc *codec.Codec, cfg types.Bech32Config, from, to, amount, seed string, options *js.Object) string {
+	defer client.Recover()
 
-	fromAddr, err := types.AccAddressFromBech32(from)
+	baseReq, err := client.BaseReqFromJS(cfg, options)
 	if err != nil {
-		panic(err)
+		client.Throw(err)
 	}
 
-	toAddr, err := types.AccAddressFromBech32(to)
+	data, err := Send(cdc, from, to, amount, seed, baseReq)
 	if err != nil {
-		panic(err)
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// Send builds and signs a MsgSend and returns the base64 encoded transaction,
+// encoded with cdc.
+func Send(cdc *codec.Codec, from, to, amount, seed string, baseReq txbuilder.BaseReq) (string, error) {
+
+	fromAddr, err := types.AccAddressFromBech32WithConfig(baseReq.Bech32, from)
+	if err != nil {
+		return "", err
+	}
+
+	toAddr, err := types.AccAddressFromBech32WithConfig(baseReq.Bech32, to)
+	if err != nil {
+		return "", err
 	}
 
 	coins, err := types.ParseCoins(amount)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 
 	msg := bank.CreateMsg(fromAddr, toAddr, coins)
-	baseReq := txbuilder.NewBaseReq(2, 6, 200000, "sentinel-vpn", "", "0STAKE").WithTxEncoder(auth.DefaultTxEncoder(jscodec.Cdc))
+	baseReq = baseReq.WithTxEncoder(auth.DefaultTxEncoder(cdc))
 
 	txBytes, err := baseReq.BuildAndSign(seed, []types.Msg{msg})
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 
 	data := base64.StdEncoding.EncodeToString(txBytes)
-	return data
+	return data, nil
 }
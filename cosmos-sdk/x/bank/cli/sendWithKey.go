@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"encoding/base64"
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/crypto/keybase"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SendCoinsWithKey is SendCoins signed by a stored key; the sender is the
+// key's address.
+func SendCoinsWithKey(cdc *codec.Codec, cfg types.Bech32Config, name, passphrase, to, amount string, options *js.Object) string {
+	defer client.Recover()
+
+	baseReq, err := client.BaseReqFromJS(cfg, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := SendWithKey(cdc, keys.GetKeybase(), name, passphrase, to, amount, baseReq)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+func SendWithKey(cdc *codec.Codec, kb keybase.Keybase, name, passphrase, to, amount string, baseReq txbuilder.BaseReq) (string, error) {
+
+	info, err := kb.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	toAddr, err := types.AccAddressFromBech32WithConfig(baseReq.Bech32, to)
+	if err != nil {
+		return "", err
+	}
+
+	coins, err := types.ParseCoins(amount)
+	if err != nil {
+		return "", err
+	}
+
+	msg := bank.CreateMsg(info.GetAddress(), toAddr, coins)
+	baseReq = baseReq.WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+	txBytes, err := baseReq.BuildAndSignWithKeybase(kb, name, passphrase, []types.Msg{msg})
+	if err != nil {
+		return "", err
+	}
+
+	data := base64.StdEncoding.EncodeToString(txBytes)
+	return data, nil
+}
@@ -3,6 +3,7 @@ package bank
 import (
 	"encoding/json"
 	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
 )
 
 const MsgRoute = "bank"
@@ -26,7 +27,33 @@ func CreateMsg(from, to types.AccAddress, coins types.Coins) types.Msg {
 func (msg MsgSend) Route() string { return MsgRoute }
 func (msg MsgSend) Type() string  { return "send" }
 
-func (msg MsgSend) ValidateBasic() error { return nil }
+func (msg MsgSend) ValidateBasic() error {
+	if len(msg.Inputs) == 0 {
+		return types.ErrInvalidRequest("no inputs to send transaction")
+	}
+	if len(msg.Outputs) == 0 {
+		return types.ErrInvalidRequest("no outputs to send transaction")
+	}
+
+	var totalIn, totalOut types.Coins
+	for _, in := range msg.Inputs {
+		if err := in.ValidateBasic(); err != nil {
+			return err
+		}
+		totalIn = totalIn.Plus(in.Coins)
+	}
+	for _, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return err
+		}
+		totalOut = totalOut.Plus(out.Coins)
+	}
+
+	if !totalIn.IsEqual(totalOut) {
+		return types.ErrInvalidCoins("inputs %s and outputs %s don't match", totalIn, totalOut)
+	}
+	return nil
+}
 
 func (msg MsgSend) GetSignBytes() []byte {
 	var inputs, outputs []json.RawMessage
@@ -36,17 +63,13 @@ func (msg MsgSend) GetSignBytes() []byte {
 	for _, output := range msg.Outputs {
 		outputs = append(outputs, output.GetSignBytes())
 	}
-	b, err := msgCdc.MarshalJSON(struct {
+	return jscodec.MustSignBytes(msgCdc, struct {
 		Inputs  []json.RawMessage `json:"inputs"`
 		Outputs []json.RawMessage `json:"outputs"`
 	}{
 		Inputs:  inputs,
 		Outputs: outputs,
 	})
-	if err != nil {
-		panic(err)
-	}
-	return b
 }
 
 func (msg MsgSend) GetSigners() []types.AccAddress {
@@ -71,14 +94,12 @@ func NewInput(addr types.AccAddress, coins types.Coins) Input {
 }
 
 func (in Input) GetSignBytes() []byte {
-	bin, err := msgCdc.MarshalJSON(in)
-	if err != nil {
-		panic(err)
-	}
-	return bin
+	return jscodec.MustSignBytes(msgCdc, in)
 }
 
-func (in Input) ValidateBasic() error { return nil }
+func (in Input) ValidateBasic() error {
+	return validateAddressCoins(in.Address, in.Coins)
+}
 
 type Output struct {
 	Address types.AccAddress `json:"address"`
@@ -94,11 +115,22 @@ func NewOutput(addr types.AccAddress, coins types.Coins) Output {
 }
 
 func (out Output) GetSignBytes() []byte {
-	bin, err := msgCdc.MarshalJSON(out)
-	if err != nil {
-		panic(err)
-	}
-	return bin
+	return jscodec.MustSignBytes(msgCdc, out)
+}
+
+func (out Output) ValidateBasic() error {
+	return validateAddressCoins(out.Address, out.Coins)
 }
 
-func (out Output) ValidateBasic() error { return nil }
+func validateAddressCoins(addr types.AccAddress, coins types.Coins) error {
+	if len(addr) == 0 {
+		return types.ErrInvalidAddress("address is empty")
+	}
+	if !coins.IsValid() {
+		return types.ErrInvalidCoins("invalid coins %s", coins)
+	}
+	if !coins.IsPositive() {
+		return types.ErrInvalidCoins("coins must be positive, got %q", coins.String())
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package bank
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	input1 = types.AccAddress([]byte("input1______________"))
+	input2 = types.AccAddress([]byte("input2______________"))
+	output = types.AccAddress([]byte("output______________"))
+	coins  = types.Coins{types.NewInt64Coin("stake", 10)}
+)
+
+func TestMsgGetSignBytes(t *testing.T) {
+	testCases := []struct {
+		msg      types.Msg
+		expected string
+	}{
+		{
+			CreateMsg(input1, output, coins),
+			`{"inputs":[{"address":"cosmos1d9h8qat5x9047h6lta047h6lta047h6lupugul","coins":[{"amount":"10","denom":"stake"}]}],"outputs":[{"address":"cosmos1da6hgur4w3047h6lta047h6lta047h6lclqftz","coins":[{"amount":"10","denom":"stake"}]}]}`,
+		},
+		{
+			NewMsgSend([]Input{NewInput(input1, coins), NewInput(input2, coins)},
+				[]Output{NewOutput(output, types.Coins{types.NewInt64Coin("stake", 20)})}),
+			`{"inputs":[{"address":"cosmos1d9h8qat5x9047h6lta047h6lta047h6lupugul","coins":[{"amount":"10","denom":"stake"}]},{"address":"cosmos1d9h8qat5xf047h6lta047h6lta047h6l5cqz3l","coins":[{"amount":"10","denom":"stake"}]}],"outputs":[{"address":"cosmos1da6hgur4w3047h6lta047h6lta047h6lclqftz","coins":[{"amount":"20","denom":"stake"}]}]}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, string(tc.msg.GetSignBytes()), "unexpected sign bytes for test case #%d", i)
+	}
+}
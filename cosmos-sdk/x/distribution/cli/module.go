@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/distribution"
+)
+
+// AppModule is the distribution module.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "distribution" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	distribution.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return []types.Msg{
+		distribution.MsgWithdrawDelegatorReward{},
+		distribution.MsgWithdrawValidatorRewardsAll{},
+		distribution.MsgSetWithdrawAddress{},
+	}
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"withdrawRewards":    WithdrawRewards,
+		"withdrawAllRewards": WithdrawAllRewards,
+		"withdrawCommission": WithdrawCommission,
+		"setWithdrawAddress": SetWithdrawAddress,
+	}
+}
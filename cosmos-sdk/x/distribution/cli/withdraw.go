@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/distribution"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// WithdrawRewards is the JS export that withdraws delegator's rewards from
+// validator. keyOrSeed and options are read with tx.BuildAndSignFromJS.
+func WithdrawRewards(cdc *codec.Codec, cfg types.Bech32Config, delegator, validator string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	delAddr, err := types.AccAddressFromBech32WithConfig(cfg, delegator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	valAddr, err := types.ValAddressFromBech32WithConfig(cfg, validator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := distribution.NewMsgWithdrawDelegatorReward(delAddr, valAddr)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// WithdrawAllRewards is the JS export that withdraws delegator's rewards from
+// every validator in the validators array in one transaction.
+func WithdrawAllRewards(cdc *codec.Codec, cfg types.Bech32Config, delegator string, validators, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	delAddr, err := types.AccAddressFromBech32WithConfig(cfg, delegator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	values, err := client.ArrayFromJS(validators, "validators")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	valAddrs := make([]types.ValAddress, len(values))
+	for i, value := range values {
+		valAddrs[i], err = types.ValAddressFromBech32WithConfig(cfg, value.String())
+		if err != nil {
+			client.Throw(err)
+		}
+	}
+
+	msgs, err := distribution.WithdrawAllRewardsMsgs(delAddr, valAddrs)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, msgs, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// WithdrawCommission is the JS export that withdraws validator's commission,
+// along with the rewards of its self-delegation, see
+// distribution.MsgWithdrawValidatorRewardsAll. It must be signed by the
+// validator's operator key.
+func WithdrawCommission(cdc *codec.Codec, cfg types.Bech32Config, validator string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	valAddr, err := types.ValAddressFromBech32WithConfig(cfg, validator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := distribution.NewMsgWithdrawValidatorRewardsAll(valAddr)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// SetWithdrawAddress is the JS export that pays delegator's future rewards to
+// withdrawAddr.
+func SetWithdrawAddress(cdc *codec.Codec, cfg types.Bech32Config, delegator, withdrawAddr string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	delAddr, err := types.AccAddressFromBech32WithConfig(cfg, delegator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	withdrawAccAddr, err := types.AccAddressFromBech32WithConfig(cfg, withdrawAddr)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := distribution.NewMsgSetWithdrawAddress(delAddr, withdrawAccAddr)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
@@ -0,0 +1,127 @@
+package distribution
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
+)
+
+const MsgRoute = "distr"
+
+var (
+	_ types.Msg = MsgWithdrawDelegatorReward{}
+	_ types.Msg = MsgWithdrawValidatorRewardsAll{}
+	_ types.Msg = MsgSetWithdrawAddress{}
+)
+
+// MsgWithdrawDelegatorReward withdraws a delegator's rewards from one
+// validator.
+type MsgWithdrawDelegatorReward struct {
+	DelegatorAddr types.AccAddress `json:"delegator_addr"`
+	ValidatorAddr types.ValAddress `json:"validator_addr"`
+}
+
+func NewMsgWithdrawDelegatorReward(delAddr types.AccAddress, valAddr types.ValAddress) MsgWithdrawDelegatorReward {
+	return MsgWithdrawDelegatorReward{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+	}
+}
+
+// WithdrawAllRewardsMsgs returns one MsgWithdrawDelegatorReward for each of
+// the delegator's validators, to be sent in a single transaction.
+func WithdrawAllRewardsMsgs(delAddr types.AccAddress, valAddrs []types.ValAddress) ([]types.Msg, error) {
+	if len(valAddrs) == 0 {
+		return nil, types.ErrInvalidRequest("no validators to withdraw rewards from")
+	}
+
+	msgs := make([]types.Msg, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		msgs[i] = NewMsgWithdrawDelegatorReward(delAddr, valAddr)
+	}
+	return msgs, nil
+}
+
+func (msg MsgWithdrawDelegatorReward) Route() string { return MsgRoute }
+func (msg MsgWithdrawDelegatorReward) Type() string  { return "withdraw_delegation_reward" }
+
+func (msg MsgWithdrawDelegatorReward) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
+	if msg.DelegatorAddr.Empty() {
+		return types.ErrInvalidAddress("delegator address is empty")
+	}
+	if msg.ValidatorAddr.Empty() {
+		return types.ErrInvalidAddress("validator address is empty")
+	}
+	return nil
+}
+
+// MsgWithdrawValidatorRewardsAll withdraws a validator's accumulated
+// commission, together with the rewards of its operator's self-delegation, to
+// its operator account. v0.29 has no message for the commission alone.
+type MsgWithdrawValidatorRewardsAll struct {
+	ValidatorAddr types.ValAddress `json:"validator_addr"`
+}
+
+func NewMsgWithdrawValidatorRewardsAll(valAddr types.ValAddress) MsgWithdrawValidatorRewardsAll {
+	return MsgWithdrawValidatorRewardsAll{ValidatorAddr: valAddr}
+}
+
+func (msg MsgWithdrawValidatorRewardsAll) Route() string { return MsgRoute }
+func (msg MsgWithdrawValidatorRewardsAll) Type() string  { return "withdraw_validator_rewards_all" }
+
+func (msg MsgWithdrawValidatorRewardsAll) GetSigners() []types.AccAddress {
+	return []types.AccAddress{types.AccAddress(msg.ValidatorAddr)}
+}
+
+func (msg MsgWithdrawValidatorRewardsAll) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgWithdrawValidatorRewardsAll) ValidateBasic() error {
+	if msg.ValidatorAddr.Empty() {
+		return types.ErrInvalidAddress("validator address is empty")
+	}
+	return nil
+}
+
+// MsgSetWithdrawAddress changes the address a delegator's rewards are paid
+// to.
+type MsgSetWithdrawAddress struct {
+	DelegatorAddr types.AccAddress `json:"delegator_addr"`
+	WithdrawAddr  types.AccAddress `json:"withdraw_addr"`
+}
+
+func NewMsgSetWithdrawAddress(delAddr, withdrawAddr types.AccAddress) MsgSetWithdrawAddress {
+	return MsgSetWithdrawAddress{
+		DelegatorAddr: delAddr,
+		WithdrawAddr:  withdrawAddr,
+	}
+}
+
+func (msg MsgSetWithdrawAddress) Route() string { return MsgRoute }
+func (msg MsgSetWithdrawAddress) Type() string  { return "set_withdraw_address" }
+
+func (msg MsgSetWithdrawAddress) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if msg.DelegatorAddr.Empty() {
+		return types.ErrInvalidAddress("delegator address is empty")
+	}
+	if msg.WithdrawAddr.Empty() {
+		return types.ErrInvalidAddress("withdraw address is empty")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package distribution
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	delAddr      = types.AccAddress([]byte("delegator___________"))
+	valAddr      = types.ValAddress([]byte("validator___________"))
+	withdrawAddr = types.AccAddress([]byte("withdraw____________"))
+)
+
+// The expected sign bytes are those of the same messages in x/distribution of
+// cosmos-sdk v0.29.1.
+func TestMsgGetSignBytes(t *testing.T) {
+	testCases := []struct {
+		msg      types.Msg
+		expected string
+	}{
+		{
+			NewMsgWithdrawDelegatorReward(delAddr, valAddr),
+			`{"type":"cosmos-sdk/MsgWithdrawDelegationReward","value":{"delegator_addr":"cosmos1v3jkcet8v96x7ujlta047h6lta047h6l5nq7h2","validator_addr":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}}`,
+		},
+		{
+			NewMsgWithdrawValidatorRewardsAll(valAddr),
+			`{"type":"cosmos-sdk/MsgWithdrawValidatorRewardsAll","value":{"validator_addr":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}}`,
+		},
+		{
+			NewMsgSetWithdrawAddress(delAddr, withdrawAddr),
+			`{"type":"cosmos-sdk/MsgModifyWithdrawAddress","value":{"delegator_addr":"cosmos1v3jkcet8v96x7ujlta047h6lta047h6l5nq7h2","withdraw_addr":"cosmos1wa5hg6rywfshwh6lta047h6lta047h6lh7kzrf"}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, string(tc.msg.GetSignBytes()), "unexpected sign bytes for test case #%d", i)
+	}
+}
+
+func TestWithdrawAllRewardsMsgs(t *testing.T) {
+	_, err := WithdrawAllRewardsMsgs(delAddr, nil)
+	require.Error(t, err)
+
+	msgs, err := WithdrawAllRewardsMsgs(delAddr, []types.ValAddress{valAddr, types.ValAddress(withdrawAddr)})
+	require.NoError(t, err)
+	require.Equal(t, []types.Msg{
+		NewMsgWithdrawDelegatorReward(delAddr, valAddr),
+		NewMsgWithdrawDelegatorReward(delAddr, types.ValAddress(withdrawAddr)),
+	}, msgs)
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/gov"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SubmitProposal is the JS export of MsgSubmitProposal. params holds
+// proposer, title, description, type ("Text", "ParameterChange" or
+// "SoftwareUpgrade", default "Text") and an optional initial_deposit such as
+// "10stake". v0.29 proposals are text only, so changes are rejected.
+func SubmitProposal(cdc *codec.Codec, cfg types.Bech32Config, params, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	msg, err := submitProposalMsg(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// Deposit is the JS export that adds amount, such as "10stake", to the
+// deposit of proposal proposalID.
+func Deposit(cdc *codec.Codec, cfg types.Bech32Config, depositor string, proposalID *js.Object, amount string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	depositorAddr, err := types.AccAddressFromBech32WithConfig(cfg, depositor)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	id, err := client.Uint64FromJS(proposalID, "proposal_id")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	coins, err := types.ParseCoins(amount)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := gov.NewMsgDeposit(depositorAddr, id, coins)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// Vote is the JS export that votes option ("Yes", "Abstain", "No" or
+// "NoWithVeto") on proposal proposalID.
+func Vote(cdc *codec.Codec, cfg types.Bech32Config, voter string, proposalID *js.Object, option string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	voterAddr, err := types.AccAddressFromBech32WithConfig(cfg, voter)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	id, err := client.Uint64FromJS(proposalID, "proposal_id")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	voteOption, err := gov.VoteOptionFromString(option)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := gov.NewMsgVote(voterAddr, id, voteOption)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+func submitProposalMsg(cfg types.Bech32Config, params *js.Object) (gov.MsgSubmitProposal, error) {
+	if client.IsUndefined(params) {
+		return gov.MsgSubmitProposal{}, types.ErrInvalidRequest("proposal params required but not specified")
+	}
+
+	proposer, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(params, "proposer"))
+	if err != nil {
+		return gov.MsgSubmitProposal{}, err
+	}
+
+	deposit, err := types.ParseCoins(client.StringOption(params, "initial_deposit"))
+	if err != nil {
+		return gov.MsgSubmitProposal{}, err
+	}
+
+	if !client.IsUndefined(params.Get("changes")) {
+		return gov.MsgSubmitProposal{}, types.ErrInvalidRequest("parameter changes need a chain with SDK v0.36 or later")
+	}
+
+	proposalType := gov.ProposalTypeText
+	if typ := client.StringOption(params, "type"); typ != "" {
+		proposalType, err = gov.ProposalTypeFromString(typ)
+		if err != nil {
+			return gov.MsgSubmitProposal{}, err
+		}
+	}
+
+	return gov.NewMsgSubmitProposal(client.StringOption(params, "title"), client.StringOption(params, "description"),
+		proposalType, proposer, deposit), nil
+}
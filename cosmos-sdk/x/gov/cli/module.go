@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/gov"
+)
+
+// AppModule is the governance module.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "gov" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	gov.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return []types.Msg{
+		gov.MsgSubmitProposal{},
+		gov.MsgDeposit{},
+		gov.MsgVote{},
+	}
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"submitProposal": SubmitProposal,
+		"deposit":        Deposit,
+		"vote":           Vote,
+	}
+}
@@ -0,0 +1,15 @@
+package gov
+
+import (
+	"github.com/baymax19/js2go/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+}
+
+// msgCdc has no types registered, so that messages marshal unwrapped in their
+// sign bytes, as in SDK v0.29.1.
+var msgCdc = codec.New()
@@ -0,0 +1,132 @@
+package gov
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
+)
+
+const MsgRoute = "gov"
+
+var (
+	_ types.Msg = MsgSubmitProposal{}
+	_ types.Msg = MsgDeposit{}
+	_ types.Msg = MsgVote{}
+)
+
+// MsgSubmitProposal submits a new proposal with an initial deposit.
+type MsgSubmitProposal struct {
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	ProposalType   ProposalKind     `json:"proposal_type"`
+	Proposer       types.AccAddress `json:"proposer"`
+	InitialDeposit types.Coins      `json:"initial_deposit"`
+}
+
+func NewMsgSubmitProposal(title, description string, proposalType ProposalKind, proposer types.AccAddress, initialDeposit types.Coins) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		ProposalType:   proposalType,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+	}
+}
+
+func (msg MsgSubmitProposal) Route() string { return MsgRoute }
+func (msg MsgSubmitProposal) Type() string  { return "submit_proposal" }
+
+func (msg MsgSubmitProposal) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.Proposer}
+}
+
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	if err := validateTitleDescription(msg.Title, msg.Description); err != nil {
+		return err
+	}
+	if !msg.ProposalType.IsValid() {
+		return types.ErrInvalidRequest("invalid proposal type %d", msg.ProposalType)
+	}
+	if msg.Proposer.Empty() {
+		return types.ErrInvalidAddress("proposer address is empty")
+	}
+	if !msg.InitialDeposit.IsValid() || !msg.InitialDeposit.IsNotNegative() {
+		return types.ErrInvalidCoins("invalid initial deposit %s", msg.InitialDeposit)
+	}
+	return nil
+}
+
+// MsgDeposit adds Amount to the deposit of a proposal.
+type MsgDeposit struct {
+	ProposalID uint64           `json:"proposal_id"`
+	Depositor  types.AccAddress `json:"depositor"`
+	Amount     types.Coins      `json:"amount"`
+}
+
+func NewMsgDeposit(depositor types.AccAddress, proposalID uint64, amount types.Coins) MsgDeposit {
+	return MsgDeposit{
+		ProposalID: proposalID,
+		Depositor:  depositor,
+		Amount:     amount,
+	}
+}
+
+func (msg MsgDeposit) Route() string { return MsgRoute }
+func (msg MsgDeposit) Type() string  { return "deposit" }
+
+func (msg MsgDeposit) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.Depositor}
+}
+
+func (msg MsgDeposit) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgDeposit) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return types.ErrInvalidAddress("depositor address is empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return types.ErrInvalidCoins("deposit amount must be positive, got %q", msg.Amount.String())
+	}
+	return nil
+}
+
+// MsgVote casts Option on a proposal.
+type MsgVote struct {
+	ProposalID uint64           `json:"proposal_id"`
+	Voter      types.AccAddress `json:"voter"`
+	Option     VoteOption       `json:"option"`
+}
+
+func NewMsgVote(voter types.AccAddress, proposalID uint64, option VoteOption) MsgVote {
+	return MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     option,
+	}
+}
+
+func (msg MsgVote) Route() string { return MsgRoute }
+func (msg MsgVote) Type() string  { return "vote" }
+
+func (msg MsgVote) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.Voter}
+}
+
+func (msg MsgVote) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgVote) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return types.ErrInvalidAddress("voter address is empty")
+	}
+	if !msg.Option.IsValid() {
+		return types.ErrInvalidRequest("invalid vote option %d", msg.Option)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package gov
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	proposer  = types.AccAddress([]byte("proposer____________"))
+	depositor = types.AccAddress([]byte("depositor___________"))
+	voter     = types.AccAddress([]byte("voter_______________"))
+	coins     = types.Coins{types.NewInt64Coin("stake", 10)}
+)
+
+// The expected sign bytes are those of the same messages in x/gov of
+// cosmos-sdk v0.29.1.
+func TestMsgGetSignBytes(t *testing.T) {
+	testCases := []struct {
+		msg      types.Msg
+		expected string
+	}{
+		{
+			NewMsgSubmitProposal("title", "description", ProposalTypeText, proposer, coins),
+			`{"description":"description","initial_deposit":[{"amount":"10","denom":"stake"}],"proposal_type":"Text","proposer":"cosmos1wpex7ur0wdjhyh6lta047h6lta047h6ljkx24t","title":"title"}`,
+		},
+		{
+			NewMsgDeposit(depositor, 2, coins),
+			`{"amount":[{"amount":"10","denom":"stake"}],"depositor":"cosmos1v3jhqmmnd96x7ujlta047h6lta047h6le65ewp","proposal_id":"2"}`,
+		},
+		{
+			NewMsgVote(voter, 2, OptionYes),
+			`{"option":"Yes","proposal_id":"2","voter":"cosmos1wehhgetjta047h6lta047h6lta047h6lmyr9t9"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, string(tc.msg.GetSignBytes()), "unexpected sign bytes for test case #%d", i)
+	}
+}
+
+func TestMsgSubmitProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		title, description string
+		proposalType       ProposalKind
+		proposer           types.AccAddress
+		initialDeposit     types.Coins
+		expectPass         bool
+	}{
+		{"title", "description", ProposalTypeText, proposer, coins, true},
+		{"title", "description", ProposalTypeParameterChange, proposer, nil, true},
+		{"", "description", ProposalTypeText, proposer, coins, false},
+		{"title", "", ProposalTypeText, proposer, coins, false},
+		{"title", "description", ProposalTypeNil, proposer, coins, false},
+		{"title", "description", ProposalTypeText, nil, coins, false},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgSubmitProposal(tc.title, tc.description, tc.proposalType, tc.proposer, tc.initialDeposit)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test case #%d", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test case #%d", i)
+		}
+	}
+}
+
+func TestProposalKindJSON(t *testing.T) {
+	for _, kind := range []ProposalKind{ProposalTypeText, ProposalTypeParameterChange, ProposalTypeSoftwareUpgrade} {
+		bz, err := kind.MarshalJSON()
+		require.NoError(t, err)
+
+		var decoded ProposalKind
+		require.NoError(t, decoded.UnmarshalJSON(bz))
+		require.Equal(t, kind, decoded)
+	}
+
+	var decoded ProposalKind
+	require.Error(t, decoded.UnmarshalJSON([]byte(`"Other"`)))
+}
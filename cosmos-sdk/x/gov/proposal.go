@@ -0,0 +1,91 @@
+package gov
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+)
+
+const (
+	MaxTitleLength       = 140
+	MaxDescriptionLength = 5000
+)
+
+// ProposalKind is the type of a proposal. It is a byte in binary encoding and
+// one of "Text", "ParameterChange" or "SoftwareUpgrade" in JSON. In v0.29
+// every proposal is text only; the kind is a label and a "ParameterChange"
+// proposal changes no parameters by itself.
+type ProposalKind byte
+
+const (
+	ProposalTypeNil             ProposalKind = 0x00
+	ProposalTypeText            ProposalKind = 0x01
+	ProposalTypeParameterChange ProposalKind = 0x02
+	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
+)
+
+func ProposalTypeFromString(str string) (ProposalKind, error) {
+	switch str {
+	case "Text":
+		return ProposalTypeText, nil
+	case "ParameterChange":
+		return ProposalTypeParameterChange, nil
+	case "SoftwareUpgrade":
+		return ProposalTypeSoftwareUpgrade, nil
+	default:
+		return ProposalTypeNil, types.ErrInvalidRequest("invalid proposal type %q", str)
+	}
+}
+
+func (pt ProposalKind) IsValid() bool {
+	return pt >= ProposalTypeText && pt <= ProposalTypeSoftwareUpgrade
+}
+
+func (pt ProposalKind) String() string {
+	switch pt {
+	case ProposalTypeText:
+		return "Text"
+	case ProposalTypeParameterChange:
+		return "ParameterChange"
+	case ProposalTypeSoftwareUpgrade:
+		return "SoftwareUpgrade"
+	default:
+		return ""
+	}
+}
+
+func (pt ProposalKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pt.String())
+}
+
+func (pt *ProposalKind) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	kind, err := ProposalTypeFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*pt = kind
+	return nil
+}
+
+func validateTitleDescription(title, description string) error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return types.ErrInvalidRequest("proposal title is empty")
+	}
+	if len(title) > MaxTitleLength {
+		return types.ErrInvalidRequest("proposal title is longer than %d characters", MaxTitleLength)
+	}
+	if len(description) == 0 {
+		return types.ErrInvalidRequest("proposal description is empty")
+	}
+	if len(description) > MaxDescriptionLength {
+		return types.ErrInvalidRequest("proposal description is longer than %d characters", MaxDescriptionLength)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package gov
+
+import (
+	"encoding/json"
+
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+)
+
+// VoteOption is a vote on a proposal. It is a byte in binary encoding and one
+// of "Yes", "Abstain", "No" or "NoWithVeto" in JSON.
+type VoteOption byte
+
+const (
+	OptionEmpty      VoteOption = 0x00
+	OptionYes        VoteOption = 0x01
+	OptionAbstain    VoteOption = 0x02
+	OptionNo         VoteOption = 0x03
+	OptionNoWithVeto VoteOption = 0x04
+)
+
+func VoteOptionFromString(str string) (VoteOption, error) {
+	switch str {
+	case "Yes":
+		return OptionYes, nil
+	case "Abstain":
+		return OptionAbstain, nil
+	case "No":
+		return OptionNo, nil
+	case "NoWithVeto":
+		return OptionNoWithVeto, nil
+	default:
+		return OptionEmpty, types.ErrInvalidRequest("invalid vote option %q", str)
+	}
+}
+
+func (vo VoteOption) IsValid() bool {
+	return vo >= OptionYes && vo <= OptionNoWithVeto
+}
+
+func (vo VoteOption) String() string {
+	switch vo {
+	case OptionYes:
+		return "Yes"
+	case OptionAbstain:
+		return "Abstain"
+	case OptionNo:
+		return "No"
+	case OptionNoWithVeto:
+		return "NoWithVeto"
+	default:
+		return ""
+	}
+}
+
+func (vo VoteOption) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vo.String())
+}
+
+func (vo *VoteOption) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	option, err := VoteOptionFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*vo = option
+	return nil
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/slashing"
+)
+
+// AppModule is the slashing module.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "slashing" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	slashing.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return []types.Msg{slashing.MsgUnjail{}}
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"unjail": Unjail,
+	}
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/slashing"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Unjail is the JS export that unjails validatorAddr. keyOrSeed must be the
+// validator's operator key; keyOrSeed and options are read with
+// tx.BuildAndSignFromJS.
+func Unjail(cdc *codec.Codec, cfg types.Bech32Config, validatorAddr string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	valAddr, err := types.ValAddressFromBech32WithConfig(cfg, validatorAddr)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := slashing.NewMsgUnjail(valAddr)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
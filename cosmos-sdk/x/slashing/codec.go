@@ -0,0 +1,13 @@
+package slashing
+
+import (
+	"github.com/baymax19/js2go/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgUnjail{}, "cosmos-sdk/MsgUnjail", nil)
+}
+
+// msgCdc has no types registered, so that MsgUnjail marshals unwrapped in its
+// sign bytes, as in SDK v0.29.1.
+var msgCdc = codec.New()
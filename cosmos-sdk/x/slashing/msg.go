@@ -0,0 +1,38 @@
+package slashing
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
+)
+
+const MsgRoute = "slashing"
+
+var _ types.Msg = MsgUnjail{}
+
+// MsgUnjail returns a jailed validator to the active set. It is signed by the
+// validator's operator account.
+type MsgUnjail struct {
+	ValidatorAddr types.ValAddress `json:"address"`
+}
+
+func NewMsgUnjail(valAddr types.ValAddress) MsgUnjail {
+	return MsgUnjail{ValidatorAddr: valAddr}
+}
+
+func (msg MsgUnjail) Route() string { return MsgRoute }
+func (msg MsgUnjail) Type() string  { return "unjail" }
+
+func (msg MsgUnjail) GetSigners() []types.AccAddress {
+	return []types.AccAddress{types.AccAddress(msg.ValidatorAddr)}
+}
+
+func (msg MsgUnjail) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgUnjail) ValidateBasic() error {
+	if msg.ValidatorAddr.Empty() {
+		return types.ErrInvalidAddress("validator address is empty")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package slashing
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMsgUnjailGetSignBytes(t *testing.T) {
+	addr := types.AccAddress("abcd")
+	msg := NewMsgUnjail(types.ValAddress(addr))
+	bytes := msg.GetSignBytes()
+	require.Equal(t, string(bytes), `{"address":"cosmosvaloper1v93xxeqhg9nn6"}`)
+}
+
+func TestMsgUnjailValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgUnjail(types.ValAddress([]byte("validator___________"))).ValidateBasic())
+	require.Error(t, NewMsgUnjail(nil).ValidateBasic())
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/staking"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Delegate is the JS export that bonds amount, such as "10stake", from
+// delegator to validator. keyOrSeed and options are read with
+// tx.BuildAndSignFromJS.
+func Delegate(cdc *codec.Codec, cfg types.Bech32Config, delegator, validator, amount string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	delAddr, valAddr, err := parseDelegation(cfg, delegator, validator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	coin, err := types.ParseCoin(amount)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := staking.NewMsgDelegate(delAddr, valAddr, coin)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// Undelegate is the JS export that starts unbonding shares, a decimal such as
+// "10.5", of delegator's delegation to validator.
+func Undelegate(cdc *codec.Codec, cfg types.Bech32Config, delegator, validator, shares string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	delAddr, valAddr, err := parseDelegation(cfg, delegator, validator)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	sharesAmount, err := types.NewDecFromStr(shares)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := staking.NewMsgBeginUnbonding(delAddr, valAddr, sharesAmount)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// Redelegate is the JS export that moves shares of delegator's delegation
+// from validatorSrc to validatorDst.
+func Redelegate(cdc *codec.Codec, cfg types.Bech32Config, delegator, validatorSrc, validatorDst, shares string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	delAddr, valSrcAddr, err := parseDelegation(cfg, delegator, validatorSrc)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	valDstAddr, err := types.ValAddressFromBech32WithConfig(cfg, validatorDst)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	sharesAmount, err := types.NewDecFromStr(shares)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := staking.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, sharesAmount)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+func parseDelegation(cfg types.Bech32Config, delegator, validator string) (types.AccAddress, types.ValAddress, error) {
+
+	delAddr, err := types.AccAddressFromBech32WithConfig(cfg, delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valAddr, err := types.ValAddressFromBech32WithConfig(cfg, validator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return delAddr, valAddr, nil
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/staking"
+)
+
+// AppModule is the staking module.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "staking" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	staking.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return []types.Msg{
+		staking.MsgCreateValidator{},
+		staking.MsgEditValidator{},
+		staking.MsgDelegate{},
+		staking.MsgBeginUnbonding{},
+		staking.MsgBeginRedelegate{},
+	}
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"delegate":        Delegate,
+		"undelegate":      Undelegate,
+		"redelegate":      Redelegate,
+		"createValidator": CreateValidator,
+		"editValidator":   EditValidator,
+	}
+}
@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/staking"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// CreateValidator is the JS export of MsgCreateValidator. params holds
+// validator_address, pubkey (cosmosvalconspub1...), amount (the
+// self-delegation), description {moniker, identity, website, details} and
+// commission {rate, max_rate, max_change_rate}. An optional delegator_address
+// funds the self-delegation instead of the operator; both must then sign, so
+// keyOrSeed is an array of the delegator's signer and the operator's.
+func CreateValidator(cdc *codec.Codec, cfg types.Bech32Config, params, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	msg, err := createValidatorMsg(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// EditValidator is the JS export of MsgEditValidator. params holds
+// validator_address, the description fields to change and an optional
+// commission_rate.
+func EditValidator(cdc *codec.Codec, cfg types.Bech32Config, params, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	msg, err := editValidatorMsg(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+func createValidatorMsg(cfg types.Bech32Config, params *js.Object) (staking.MsgCreateValidator, error) {
+	if client.IsUndefined(params) {
+		return staking.MsgCreateValidator{}, types.ErrInvalidRequest("validator params required but not specified")
+	}
+
+	valAddr, err := types.ValAddressFromBech32WithConfig(cfg, client.StringOption(params, "validator_address"))
+	if err != nil {
+		return staking.MsgCreateValidator{}, err
+	}
+
+	delAddr := types.AccAddress(valAddr)
+	if delegator := client.StringOption(params, "delegator_address"); delegator != "" {
+		delAddr, err = types.AccAddressFromBech32WithConfig(cfg, delegator)
+		if err != nil {
+			return staking.MsgCreateValidator{}, err
+		}
+	}
+
+	pubKey, err := types.ConsPubKeyFromBech32StringWithConfig(cfg, client.StringOption(params, "pubkey"))
+	if err != nil {
+		return staking.MsgCreateValidator{}, err
+	}
+
+	amount, err := types.ParseCoin(client.StringOption(params, "amount"))
+	if err != nil {
+		return staking.MsgCreateValidator{}, err
+	}
+
+	commission := params.Get("commission")
+	rate, err := decFromJS(commission, "rate")
+	if err != nil {
+		return staking.MsgCreateValidator{}, err
+	}
+
+	maxRate, err := decFromJS(commission, "max_rate")
+	if err != nil {
+		return staking.MsgCreateValidator{}, err
+	}
+
+	maxChangeRate, err := decFromJS(commission, "max_change_rate")
+	if err != nil {
+		return staking.MsgCreateValidator{}, err
+	}
+
+	description := descriptionFromJS(params.Get("description"), "")
+
+	return staking.NewMsgCreateValidatorOnBehalfOf(delAddr, valAddr, pubKey, amount, description,
+		staking.NewCommissionMsg(rate, maxRate, maxChangeRate)), nil
+}
+
+func editValidatorMsg(cfg types.Bech32Config, params *js.Object) (staking.MsgEditValidator, error) {
+	if client.IsUndefined(params) {
+		return staking.MsgEditValidator{}, types.ErrInvalidRequest("validator params required but not specified")
+	}
+
+	valAddr, err := types.ValAddressFromBech32WithConfig(cfg, client.StringOption(params, "validator_address"))
+	if err != nil {
+		return staking.MsgEditValidator{}, err
+	}
+
+	var newRate *types.Dec
+	if !client.IsUndefined(params.Get("commission_rate")) {
+		rate, err := decFromJS(params, "commission_rate")
+		if err != nil {
+			return staking.MsgEditValidator{}, err
+		}
+		newRate = &rate
+	}
+
+	description := descriptionFromJS(params.Get("description"), staking.DoNotModifyDesc)
+
+	return staking.NewMsgEditValidator(valAddr, description, newRate), nil
+}
+
+// descriptionFromJS reads a Description, using unset for missing fields.
+func descriptionFromJS(value *js.Object, unset string) staking.Description {
+	field := func(key string) string {
+		if client.IsUndefined(value) || client.IsUndefined(value.Get(key)) {
+			return unset
+		}
+		return value.Get(key).String()
+	}
+
+	return staking.NewDescription(field("moniker"), field("identity"), field("website"), field("details"))
+}
+
+func decFromJS(options *js.Object, key string) (types.Dec, error) {
+	value := client.StringOption(options, key)
+	if value == "" {
+		return types.Dec{}, types.ErrInvalidRequest("%s required but not specified", key)
+	}
+
+	dec, err := types.NewDecFromStr(value)
+	if err != nil {
+		return types.Dec{}, types.ErrInvalidRequest("invalid %s %q: %s", key, value, err)
+	}
+	return dec, nil
+}
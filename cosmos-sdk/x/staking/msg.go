@@ -0,0 +1,157 @@
+package staking
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
+)
+
+const MsgRoute = "stake"
+
+var (
+	_ types.Msg = MsgDelegate{}
+	_ types.Msg = MsgBeginUnbonding{}
+	_ types.Msg = MsgBeginRedelegate{}
+)
+
+// MsgDelegate bonds Delegation to a validator.
+type MsgDelegate struct {
+	DelegatorAddr types.AccAddress `json:"delegator_addr"`
+	ValidatorAddr types.ValAddress `json:"validator_addr"`
+	Delegation    types.Coin       `json:"delegation"`
+}
+
+func NewMsgDelegate(delAddr types.AccAddress, valAddr types.ValAddress, delegation types.Coin) MsgDelegate {
+	return MsgDelegate{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		Delegation:    delegation,
+	}
+}
+
+func (msg MsgDelegate) Route() string { return MsgRoute }
+func (msg MsgDelegate) Type() string  { return "delegate" }
+
+func (msg MsgDelegate) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgDelegate) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgDelegate) ValidateBasic() error {
+	if err := validateDelegation(msg.DelegatorAddr, msg.ValidatorAddr); err != nil {
+		return err
+	}
+	if msg.Delegation.Denom == "" || !msg.Delegation.IsPositive() {
+		return types.ErrInvalidCoins("delegation amount must be positive, got %s", msg.Delegation)
+	}
+	return nil
+}
+
+// MsgBeginUnbonding starts unbonding SharesAmount of a delegation.
+type MsgBeginUnbonding struct {
+	DelegatorAddr types.AccAddress `json:"delegator_addr"`
+	ValidatorAddr types.ValAddress `json:"validator_addr"`
+	SharesAmount  types.Dec        `json:"shares_amount"`
+}
+
+func NewMsgBeginUnbonding(delAddr types.AccAddress, valAddr types.ValAddress, sharesAmount types.Dec) MsgBeginUnbonding {
+	return MsgBeginUnbonding{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		SharesAmount:  sharesAmount,
+	}
+}
+
+func (msg MsgBeginUnbonding) Route() string { return MsgRoute }
+func (msg MsgBeginUnbonding) Type() string  { return "begin_unbonding" }
+
+func (msg MsgBeginUnbonding) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgBeginUnbonding) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, struct {
+		DelegatorAddr types.AccAddress `json:"delegator_addr"`
+		ValidatorAddr types.ValAddress `json:"validator_addr"`
+		SharesAmount  string           `json:"shares_amount"`
+	}{
+		DelegatorAddr: msg.DelegatorAddr,
+		ValidatorAddr: msg.ValidatorAddr,
+		SharesAmount:  msg.SharesAmount.String(),
+	})
+}
+
+func (msg MsgBeginUnbonding) ValidateBasic() error {
+	if err := validateDelegation(msg.DelegatorAddr, msg.ValidatorAddr); err != nil {
+		return err
+	}
+	return validateShares(msg.SharesAmount)
+}
+
+// MsgBeginRedelegate moves SharesAmount of a delegation from one validator to
+// another.
+type MsgBeginRedelegate struct {
+	DelegatorAddr    types.AccAddress `json:"delegator_addr"`
+	ValidatorSrcAddr types.ValAddress `json:"validator_src_addr"`
+	ValidatorDstAddr types.ValAddress `json:"validator_dst_addr"`
+	SharesAmount     types.Dec        `json:"shares_amount"`
+}
+
+func NewMsgBeginRedelegate(delAddr types.AccAddress, valSrcAddr, valDstAddr types.ValAddress, sharesAmount types.Dec) MsgBeginRedelegate {
+	return MsgBeginRedelegate{
+		DelegatorAddr:    delAddr,
+		ValidatorSrcAddr: valSrcAddr,
+		ValidatorDstAddr: valDstAddr,
+		SharesAmount:     sharesAmount,
+	}
+}
+
+func (msg MsgBeginRedelegate) Route() string { return MsgRoute }
+func (msg MsgBeginRedelegate) Type() string  { return "begin_redelegate" }
+
+func (msg MsgBeginRedelegate) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgBeginRedelegate) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, struct {
+		DelegatorAddr    types.AccAddress `json:"delegator_addr"`
+		ValidatorSrcAddr types.ValAddress `json:"validator_src_addr"`
+		ValidatorDstAddr types.ValAddress `json:"validator_dst_addr"`
+		SharesAmount     string           `json:"shares"`
+	}{
+		DelegatorAddr:    msg.DelegatorAddr,
+		ValidatorSrcAddr: msg.ValidatorSrcAddr,
+		ValidatorDstAddr: msg.ValidatorDstAddr,
+		SharesAmount:     msg.SharesAmount.String(),
+	})
+}
+
+func (msg MsgBeginRedelegate) ValidateBasic() error {
+	if err := validateDelegation(msg.DelegatorAddr, msg.ValidatorSrcAddr); err != nil {
+		return err
+	}
+	if msg.ValidatorDstAddr.Empty() {
+		return types.ErrInvalidAddress("validator destination address is empty")
+	}
+	return validateShares(msg.SharesAmount)
+}
+
+func validateDelegation(delAddr types.AccAddress, valAddr types.ValAddress) error {
+	if delAddr.Empty() {
+		return types.ErrInvalidAddress("delegator address is empty")
+	}
+	if valAddr.Empty() {
+		return types.ErrInvalidAddress("validator address is empty")
+	}
+	return nil
+}
+
+func validateShares(shares types.Dec) error {
+	if shares.IsNil() || !shares.IsPositive() {
+		return types.ErrInvalidRequest("shares amount must be positive, got %v", shares)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package staking
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+var (
+	delAddr  = types.AccAddress([]byte("delegator___________"))
+	valAddr1 = types.ValAddress([]byte("validator___________"))
+	valAddr2 = types.ValAddress([]byte("validator2__________"))
+)
+
+func consPubKey() ed25519.PubKeyEd25519 {
+	var pubKey ed25519.PubKeyEd25519
+	for i := range pubKey {
+		pubKey[i] = byte(i)
+	}
+	return pubKey
+}
+
+// The expected sign bytes are those of the same messages in x/stake of
+// cosmos-sdk v0.29.1.
+func TestMsgGetSignBytes(t *testing.T) {
+	rate := types.NewDecWithPrec(1, 1)
+
+	testCases := []struct {
+		msg      types.Msg
+		expected string
+	}{
+		{
+			NewMsgCreateValidatorOnBehalfOf(delAddr, valAddr1, consPubKey(), types.NewInt64Coin("stake", 100),
+				NewDescription("moniker", "identity", "website", "details"),
+				NewCommissionMsg(rate, types.NewDecWithPrec(2, 1), types.NewDecWithPrec(1, 2))),
+			`{"Commission":{"max_change_rate":"0","max_rate":"0","rate":"0"},"Description":{"details":"details","identity":"identity","moniker":"moniker","website":"website"},"delegation":{"amount":"100","denom":"stake"},"delegator_address":"cosmos1550dq7","pubkey":"cosmosvalconspub1zcjduepqqqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0s68w5uc","validator_address":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}`,
+		},
+		{
+			NewMsgEditValidator(valAddr1, NewDescription("moniker", DoNotModifyDesc, DoNotModifyDesc, DoNotModifyDesc), &rate),
+			`{"Description":{"details":"[do-not-modify]","identity":"[do-not-modify]","moniker":"moniker","website":"[do-not-modify]"},"address":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}`,
+		},
+		{
+			NewMsgEditValidator(valAddr1, NewDescription("moniker", "", "", ""), nil),
+			`{"Description":{"details":"","identity":"","moniker":"moniker","website":""},"address":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}`,
+		},
+		{
+			NewMsgDelegate(delAddr, valAddr1, types.NewInt64Coin("stake", 10)),
+			`{"type":"cosmos-sdk/MsgDelegate","value":{"delegation":{"amount":"10","denom":"stake"},"delegator_addr":"cosmos1v3jkcet8v96x7ujlta047h6lta047h6l5nq7h2","validator_addr":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}}`,
+		},
+		{
+			NewMsgBeginUnbonding(delAddr, valAddr1, types.NewDecWithPrec(105, 1)),
+			`{"delegator_addr":"cosmos1v3jkcet8v96x7ujlta047h6lta047h6l5nq7h2","shares_amount":"10.5000000000","validator_addr":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}`,
+		},
+		{
+			NewMsgBeginRedelegate(delAddr, valAddr1, valAddr2, types.NewDec(1)),
+			`{"delegator_addr":"cosmos1v3jkcet8v96x7ujlta047h6lta047h6l5nq7h2","shares":"1.0000000000","validator_dst_addr":"cosmosvaloper1weskc6tyv96x7u3jta047h6lta047h6l7a4mqr","validator_src_addr":"cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, string(tc.msg.GetSignBytes()), "unexpected sign bytes for test case #%d", i)
+	}
+}
+
+func TestMsgCreateValidatorJSON(t *testing.T) {
+	msg := NewMsgCreateValidator(valAddr1, consPubKey(), types.NewInt64Coin("stake", 100),
+		NewDescription("moniker", "", "", ""),
+		NewCommissionMsg(types.NewDecWithPrec(1, 1), types.NewDecWithPrec(2, 1), types.NewDecWithPrec(1, 2)))
+
+	var decoded MsgCreateValidator
+	require.NoError(t, msgCdc.UnmarshalJSON(msgCdc.MustMarshalJSON(msg), &decoded))
+	require.True(t, decoded.PubKey.Equals(msg.PubKey))
+	require.Equal(t, msg.Description, decoded.Description)
+	require.True(t, decoded.Commission.Rate.Equal(msg.Commission.Rate))
+	require.Equal(t, []types.AccAddress{types.AccAddress(valAddr1)}, decoded.GetSigners())
+}
+
+func TestMsgCreateValidatorSigners(t *testing.T) {
+	msg := NewMsgCreateValidatorOnBehalfOf(delAddr, valAddr1, consPubKey(), types.NewInt64Coin("stake", 100),
+		NewDescription("moniker", "", "", ""),
+		NewCommissionMsg(types.NewDecWithPrec(1, 1), types.NewDecWithPrec(2, 1), types.NewDecWithPrec(1, 2)))
+
+	require.Equal(t, []types.AccAddress{delAddr, types.AccAddress(valAddr1)}, msg.GetSigners())
+	require.NoError(t, msg.ValidateBasic())
+}
@@ -0,0 +1,219 @@
+package staking
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// DoNotModifyDesc leaves a Description field unchanged in MsgEditValidator.
+const DoNotModifyDesc = "[do-not-modify]"
+
+var (
+	_ types.Msg = MsgCreateValidator{}
+	_ types.Msg = MsgEditValidator{}
+)
+
+type Description struct {
+	Moniker  string `json:"moniker"`
+	Identity string `json:"identity"`
+	Website  string `json:"website"`
+	Details  string `json:"details"`
+}
+
+func NewDescription(moniker, identity, website, details string) Description {
+	return Description{
+		Moniker:  moniker,
+		Identity: identity,
+		Website:  website,
+		Details:  details,
+	}
+}
+
+// EnsureLength checks the field lengths the chain accepts.
+func (d Description) EnsureLength() error {
+	for _, field := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"moniker", d.Moniker, 70},
+		{"identity", d.Identity, 3000},
+		{"website", d.Website, 140},
+		{"details", d.Details, 280},
+	} {
+		if len(field.value) > field.max {
+			return types.ErrInvalidRequest("invalid %s length; got: %d, max: %d", field.name, len(field.value), field.max)
+		}
+	}
+	return nil
+}
+
+// CommissionMsg holds the commission rates of a new validator.
+type CommissionMsg struct {
+	Rate          types.Dec `json:"rate"`
+	MaxRate       types.Dec `json:"max_rate"`
+	MaxChangeRate types.Dec `json:"max_change_rate"`
+}
+
+func NewCommissionMsg(rate, maxRate, maxChangeRate types.Dec) CommissionMsg {
+	return CommissionMsg{
+		Rate:          rate,
+		MaxRate:       maxRate,
+		MaxChangeRate: maxChangeRate,
+	}
+}
+
+func (c CommissionMsg) Validate() error {
+	switch {
+	case c.Rate.IsNil() || c.MaxRate.IsNil() || c.MaxChangeRate.IsNil():
+		return types.ErrInvalidRequest("commission rate, max rate and max change rate are required")
+	case c.MaxRate.IsNegative() || c.MaxRate.GT(types.OneDec()):
+		return types.ErrInvalidRequest("commission max rate must be between 0 and 1, got %v", c.MaxRate)
+	case c.Rate.IsNegative():
+		return types.ErrInvalidRequest("commission rate must not be negative, got %v", c.Rate)
+	case c.Rate.GT(c.MaxRate):
+		return types.ErrInvalidRequest("commission rate %v cannot be more than the max rate %v", c.Rate, c.MaxRate)
+	case c.MaxChangeRate.IsNegative():
+		return types.ErrInvalidRequest("commission max change rate must not be negative, got %v", c.MaxChangeRate)
+	case c.MaxChangeRate.GT(c.MaxRate):
+		return types.ErrInvalidRequest("commission max change rate %v cannot be more than the max rate %v", c.MaxChangeRate, c.MaxRate)
+	}
+	return nil
+}
+
+// MsgCreateValidator creates a validator for the consensus key PubKey, bonded
+// with the self-delegation Delegation. As in SDK v0.29, Description is
+// embedded and Commission untagged, so amino gives them the keys
+// "Description" and "Commission".
+type MsgCreateValidator struct {
+	Description
+	Commission    CommissionMsg
+	DelegatorAddr types.AccAddress `json:"delegator_address"`
+	ValidatorAddr types.ValAddress `json:"validator_address"`
+	PubKey        crypto.PubKey    `json:"pubkey"`
+	Delegation    types.Coin       `json:"delegation"`
+}
+
+func NewMsgCreateValidator(valAddr types.ValAddress, pubKey crypto.PubKey, selfDelegation types.Coin,
+	description Description, commission CommissionMsg) MsgCreateValidator {
+	return NewMsgCreateValidatorOnBehalfOf(types.AccAddress(valAddr), valAddr, pubKey, selfDelegation, description, commission)
+}
+
+// NewMsgCreateValidatorOnBehalfOf makes delAddr, rather than the operator,
+// fund the self-delegation; both must sign.
+func NewMsgCreateValidatorOnBehalfOf(delAddr types.AccAddress, valAddr types.ValAddress, pubKey crypto.PubKey,
+	delegation types.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
+	return MsgCreateValidator{
+		Description:   description,
+		Commission:    commission,
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		PubKey:        pubKey,
+		Delegation:    delegation,
+	}
+}
+
+func (msg MsgCreateValidator) Route() string { return MsgRoute }
+func (msg MsgCreateValidator) Type() string  { return "create_validator" }
+
+// GetSigners returns the delegator and, when it is another account, the
+// validator operator.
+func (msg MsgCreateValidator) GetSigners() []types.AccAddress {
+	addrs := []types.AccAddress{msg.DelegatorAddr}
+
+	if !msg.DelegatorAddr.Equals(types.AccAddress(msg.ValidatorAddr)) {
+		addrs = append(addrs, types.AccAddress(msg.ValidatorAddr))
+	}
+	return addrs
+}
+
+// GetSignBytes gives PubKey in bech32, such as cosmosvalconspub1..., and
+// leaves out the delegator and the commission, as SDK v0.29.1 does.
+func (msg MsgCreateValidator) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, struct {
+		Description
+		Commission    CommissionMsg
+		DelegatorAddr types.AccAddress `json:"delegator_address"`
+		ValidatorAddr types.ValAddress `json:"validator_address"`
+		PubKey        string           `json:"pubkey"`
+		Delegation    types.Coin       `json:"delegation"`
+	}{
+		Description:   msg.Description,
+		ValidatorAddr: msg.ValidatorAddr,
+		PubKey:        types.MustBech32ifyConsPub(msg.PubKey),
+		Delegation:    msg.Delegation,
+	})
+}
+
+func (msg MsgCreateValidator) ValidateBasic() error {
+	if err := validateDelegation(msg.DelegatorAddr, msg.ValidatorAddr); err != nil {
+		return err
+	}
+	if msg.PubKey == nil {
+		return types.ErrInvalidPubKey("validator consensus key is empty")
+	}
+	if msg.Delegation.Denom == "" || !msg.Delegation.IsPositive() {
+		return types.ErrInvalidCoins("self delegation must be positive, got %s", msg.Delegation)
+	}
+	if msg.Description == (Description{}) {
+		return types.ErrInvalidRequest("description must be included")
+	}
+	if err := msg.Description.EnsureLength(); err != nil {
+		return err
+	}
+	return msg.Commission.Validate()
+}
+
+// MsgEditValidator changes a validator's description, leaving fields set to
+// DoNotModifyDesc unchanged, and optionally its commission rate. Description
+// is embedded as in SDK v0.29; amino gives it the key "Description".
+type MsgEditValidator struct {
+	Description
+	ValidatorAddr  types.ValAddress `json:"address"`
+	CommissionRate *types.Dec       `json:"commission_rate"`
+}
+
+func NewMsgEditValidator(valAddr types.ValAddress, description Description, newRate *types.Dec) MsgEditValidator {
+	return MsgEditValidator{
+		Description:    description,
+		ValidatorAddr:  valAddr,
+		CommissionRate: newRate,
+	}
+}
+
+func (msg MsgEditValidator) Route() string { return MsgRoute }
+func (msg MsgEditValidator) Type() string  { return "edit_validator" }
+
+func (msg MsgEditValidator) GetSigners() []types.AccAddress {
+	return []types.AccAddress{types.AccAddress(msg.ValidatorAddr)}
+}
+
+// GetSignBytes leaves out CommissionRate, as SDK v0.29.1 does.
+func (msg MsgEditValidator) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, struct {
+		Description
+		ValidatorAddr types.ValAddress `json:"address"`
+	}{
+		Description:   msg.Description,
+		ValidatorAddr: msg.ValidatorAddr,
+	})
+}
+
+func (msg MsgEditValidator) ValidateBasic() error {
+	if msg.ValidatorAddr.Empty() {
+		return types.ErrInvalidAddress("validator address is empty")
+	}
+	if msg.Description == (Description{}) {
+		return types.ErrInvalidRequest("transaction must include some information to modify")
+	}
+	if err := msg.Description.EnsureLength(); err != nil {
+		return err
+	}
+	if msg.CommissionRate != nil {
+		if msg.CommissionRate.IsNil() || msg.CommissionRate.IsNegative() || msg.CommissionRate.GT(types.OneDec()) {
+			return types.ErrInvalidRequest("commission rate must be between 0 and 1, got %v", *msg.CommissionRate)
+		}
+	}
+	return nil
+}
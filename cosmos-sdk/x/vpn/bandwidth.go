@@ -0,0 +1,51 @@
+package vpn
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	jscodec "github.com/baymax19/js2go/types"
+)
+
+// Bandwidth is an amount of upload and download traffic in bytes.
+type Bandwidth struct {
+	Upload   types.Int `json:"upload"`
+	Download types.Int `json:"download"`
+}
+
+func NewBandwidth(upload, download types.Int) Bandwidth {
+	return Bandwidth{Upload: upload, Download: download}
+}
+
+func (b Bandwidth) IsPositive() bool {
+	return !b.Upload.IsNil() && !b.Download.IsNil() &&
+		b.Upload.Sign() > 0 && b.Download.Sign() > 0
+}
+
+// IsZero reports whether b has neither upload nor download, as when it is
+// left unset.
+func (b Bandwidth) IsZero() bool {
+	return (b.Upload.IsNil() || b.Upload.IsZero()) &&
+		(b.Download.IsNil() || b.Download.IsZero())
+}
+
+// BandwidthSignData is what the client and the node owner both sign to agree
+// on the bandwidth used in a subscription. IDs are the hub's sdk.ID, a uint64
+// that amino encodes as a decimal string such as "2".
+type BandwidthSignData struct {
+	ID        uint64           `json:"id"`
+	Bandwidth Bandwidth        `json:"bandwidth"`
+	NodeOwner types.AccAddress `json:"node_owner"`
+	Client    types.AccAddress `json:"client"`
+}
+
+func NewBandwidthSignData(id uint64, bandwidth Bandwidth, nodeOwner, client types.AccAddress) BandwidthSignData {
+	return BandwidthSignData{
+		ID:        id,
+		Bandwidth: bandwidth,
+		NodeOwner: nodeOwner,
+		Client:    client,
+	}
+}
+
+func (data BandwidthSignData) GetBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, data)
+}
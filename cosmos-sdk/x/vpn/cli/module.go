@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	"github.com/baymax19/js2go/cosmos-sdk/x/vpn"
+)
+
+// AppModule is the Sentinel hub's VPN module.
+type AppModule struct {
+	module.MsgValidator
+}
+
+var _ module.Module = AppModule{}
+
+func (AppModule) Name() string { return "vpn" }
+
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	vpn.RegisterCodec(cdc)
+}
+
+func (AppModule) Msgs() []types.Msg {
+	return []types.Msg{
+		vpn.MsgRegisterNode{},
+		vpn.MsgUpdateNodeInfo{},
+		vpn.MsgDeregisterNode{},
+		vpn.MsgStartSubscription{},
+		vpn.MsgUpdateSessionInfo{},
+	}
+}
+
+func (AppModule) Exports() map[string]interface{} {
+	return map[string]interface{}{
+		"registerNode":      RegisterNode,
+		"updateNode":        UpdateNode,
+		"deregisterNode":    DeregisterNode,
+		"startSubscription": StartSubscription,
+		"signBandwidth":     SignBandwidth,
+		"updateSessionInfo": UpdateSessionInfo,
+	}
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/vpn"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// RegisterNode is the JS export of MsgRegisterNode. params holds from, type,
+// version, moniker, prices_per_gb such as "100sent", internet_speed
+// {upload, download} and encryption.
+func RegisterNode(cdc *codec.Codec, cfg types.Bech32Config, params, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	msg, err := registerNodeMsg(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// UpdateNode is the JS export of MsgUpdateNodeInfo. params holds from, id and
+// the RegisterNode fields to change.
+func UpdateNode(cdc *codec.Codec, cfg types.Bech32Config, params, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	msg, err := updateNodeMsg(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// DeregisterNode is the JS export that removes node nodeID owned by from.
+func DeregisterNode(cdc *codec.Codec, cfg types.Bech32Config, from string, nodeID, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	fromAddr, err := types.AccAddressFromBech32WithConfig(cfg, from)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	id, err := client.Uint64FromJS(nodeID, "node_id")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := vpn.NewMsgDeregisterNode(fromAddr, id)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+func registerNodeMsg(cfg types.Bech32Config, params *js.Object) (vpn.MsgRegisterNode, error) {
+	if client.IsUndefined(params) {
+		return vpn.MsgRegisterNode{}, types.ErrInvalidRequest("node params required but not specified")
+	}
+
+	from, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(params, "from"))
+	if err != nil {
+		return vpn.MsgRegisterNode{}, err
+	}
+
+	prices, err := types.ParseCoins(client.StringOption(params, "prices_per_gb"))
+	if err != nil {
+		return vpn.MsgRegisterNode{}, err
+	}
+
+	speed, err := bandwidthFromJS(params.Get("internet_speed"), "internet_speed")
+	if err != nil {
+		return vpn.MsgRegisterNode{}, err
+	}
+
+	return vpn.NewMsgRegisterNode(from, client.StringOption(params, "type"),
+		client.StringOption(params, "version"), client.StringOption(params, "moniker"),
+		prices, speed, client.StringOption(params, "encryption")), nil
+}
+
+func updateNodeMsg(cfg types.Bech32Config, params *js.Object) (vpn.MsgUpdateNodeInfo, error) {
+	if client.IsUndefined(params) {
+		return vpn.MsgUpdateNodeInfo{}, types.ErrInvalidRequest("node params required but not specified")
+	}
+
+	from, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(params, "from"))
+	if err != nil {
+		return vpn.MsgUpdateNodeInfo{}, err
+	}
+
+	id, err := client.Uint64FromJS(params.Get("id"), "id")
+	if err != nil {
+		return vpn.MsgUpdateNodeInfo{}, err
+	}
+
+	prices, err := types.ParseCoins(client.StringOption(params, "prices_per_gb"))
+	if err != nil {
+		return vpn.MsgUpdateNodeInfo{}, err
+	}
+
+	speed := vpn.NewBandwidth(types.ZeroInt(), types.ZeroInt())
+	if !client.IsUndefined(params.Get("internet_speed")) {
+		speed, err = bandwidthFromJS(params.Get("internet_speed"), "internet_speed")
+		if err != nil {
+			return vpn.MsgUpdateNodeInfo{}, err
+		}
+	}
+
+	return vpn.NewMsgUpdateNodeInfo(from, id, client.StringOption(params, "type"),
+		client.StringOption(params, "version"), client.StringOption(params, "moniker"),
+		prices, speed, client.StringOption(params, "encryption")), nil
+}
+
+func bandwidthFromJS(value *js.Object, name string) (vpn.Bandwidth, error) {
+	if client.IsUndefined(value) {
+		return vpn.Bandwidth{}, types.ErrInvalidRequest("%s required but not specified", name)
+	}
+
+	upload, ok := types.NewIntFromString(client.StringOption(value, "upload"))
+	if !ok {
+		return vpn.Bandwidth{}, types.ErrInvalidRequest("invalid %s upload: %s", name, client.StringOption(value, "upload"))
+	}
+
+	download, ok := types.NewIntFromString(client.StringOption(value, "download"))
+	if !ok {
+		return vpn.Bandwidth{}, types.ErrInvalidRequest("invalid %s download: %s", name, client.StringOption(value, "download"))
+	}
+
+	return vpn.NewBandwidth(upload, download), nil
+}
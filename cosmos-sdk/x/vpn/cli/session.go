@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/client/tx"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/baymax19/js2go/cosmos-sdk/x/vpn"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// StartSubscription is the JS export that subscribes from to node nodeID,
+// locking deposit, such as "100sent", to pay for bandwidth.
+func StartSubscription(cdc *codec.Codec, cfg types.Bech32Config, from string, nodeID *js.Object, deposit string, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	fromAddr, err := types.AccAddressFromBech32WithConfig(cfg, from)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	id, err := client.Uint64FromJS(nodeID, "node_id")
+	if err != nil {
+		client.Throw(err)
+	}
+
+	coin, err := types.ParseCoin(deposit)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	msg := vpn.NewMsgStartSubscription(fromAddr, id, coin)
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// SignBandwidth is the JS export of BandwidthSignature. params holds
+// subscription_id, bandwidth {upload, download}, node_owner and client.
+func SignBandwidth(cfg types.Bech32Config, params, keyOrSeed *js.Object) string {
+	defer client.Recover()
+
+	signData, err := bandwidthSignDataFromJS(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	signer, err := keys.SignerFromJS(keyOrSeed)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := BandwidthSignature(cfg, signData, signer)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// UpdateSessionInfo is the JS export of MsgUpdateSessionInfo, which pays for
+// bandwidth out of a subscription's deposit. params holds from and the
+// SignBandwidth params, plus node_owner_signature and client_signature as
+// returned by SignBandwidth.
+func UpdateSessionInfo(cdc *codec.Codec, cfg types.Bech32Config, params, keyOrSeed, options *js.Object) string {
+	defer client.Recover()
+
+	msg, err := updateSessionInfoMsg(cfg, params)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	data, err := tx.BuildAndSignFromJS(cdc, cfg, []types.Msg{msg}, keyOrSeed, options)
+	if err != nil {
+		client.Throw(err)
+	}
+
+	return data
+}
+
+// BandwidthSignature returns the amino JSON signature of signer over
+// signData, with the addresses in it in the prefixes of cfg.
+func BandwidthSignature(cfg types.Bech32Config, signData vpn.BandwidthSignData, signer txbuilder.Signer) (string, error) {
+	var bz []byte
+	cfg.WithJSON(func() {
+		bz = signData.GetBytes()
+	})
+
+	sig, err := signer.Sign(bz)
+	if err != nil {
+		return "", err
+	}
+
+	bz, err = codec.Cdc.MarshalJSON(sig)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+func updateSessionInfoMsg(cfg types.Bech32Config, params *js.Object) (vpn.MsgUpdateSessionInfo, error) {
+	signData, err := bandwidthSignDataFromJS(cfg, params)
+	if err != nil {
+		return vpn.MsgUpdateSessionInfo{}, err
+	}
+
+	from, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(params, "from"))
+	if err != nil {
+		return vpn.MsgUpdateSessionInfo{}, err
+	}
+
+	nodeOwnerSig, err := signatureFromJSON(client.StringOption(params, "node_owner_signature"), "node_owner_signature")
+	if err != nil {
+		return vpn.MsgUpdateSessionInfo{}, err
+	}
+
+	clientSig, err := signatureFromJSON(client.StringOption(params, "client_signature"), "client_signature")
+	if err != nil {
+		return vpn.MsgUpdateSessionInfo{}, err
+	}
+
+	if !types.AccAddress(nodeOwnerSig.PubKey.Address()).Equals(signData.NodeOwner) {
+		return vpn.MsgUpdateSessionInfo{}, types.ErrInvalidPubKey("node_owner_signature is not from %s", signData.NodeOwner)
+	}
+	if !types.AccAddress(clientSig.PubKey.Address()).Equals(signData.Client) {
+		return vpn.MsgUpdateSessionInfo{}, types.ErrInvalidPubKey("client_signature is not from %s", signData.Client)
+	}
+
+	return vpn.NewMsgUpdateSessionInfo(from, signData.ID, signData.Bandwidth, nodeOwnerSig, clientSig), nil
+}
+
+func bandwidthSignDataFromJS(cfg types.Bech32Config, params *js.Object) (vpn.BandwidthSignData, error) {
+	if client.IsUndefined(params) {
+		return vpn.BandwidthSignData{}, types.ErrInvalidRequest("session params required but not specified")
+	}
+
+	id, err := client.Uint64FromJS(params.Get("subscription_id"), "subscription_id")
+	if err != nil {
+		return vpn.BandwidthSignData{}, err
+	}
+
+	bandwidth, err := bandwidthFromJS(params.Get("bandwidth"), "bandwidth")
+	if err != nil {
+		return vpn.BandwidthSignData{}, err
+	}
+
+	nodeOwner, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(params, "node_owner"))
+	if err != nil {
+		return vpn.BandwidthSignData{}, err
+	}
+
+	clientAddr, err := types.AccAddressFromBech32WithConfig(cfg, client.StringOption(params, "client"))
+	if err != nil {
+		return vpn.BandwidthSignData{}, err
+	}
+
+	return vpn.NewBandwidthSignData(id, bandwidth, nodeOwner, clientAddr), nil
+}
+
+func signatureFromJSON(sigJSON, name string) (auth.StdSignature, error) {
+	if sigJSON == "" {
+		return auth.StdSignature{}, types.ErrInvalidRequest("%s required but not specified", name)
+	}
+
+	var sig auth.StdSignature
+	if err := codec.Cdc.UnmarshalJSON([]byte(sigJSON), &sig); err != nil || sig.PubKey == nil {
+		return auth.StdSignature{}, types.ErrInvalidRequest("invalid %s: %s", name, sigJSON)
+	}
+	return sig, nil
+}
@@ -0,0 +1,23 @@
+package vpn
+
+import (
+	"github.com/baymax19/js2go/codec"
+)
+
+// RegisterCodec registers the messages under the snake_case names of the
+// hub's x/vpn codec, x/vpn/types/codec.go in github.com/sentinel-official/hub.
+// The names are part of the sign bytes.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterNode{}, "x/vpn/msg_register_node", nil)
+	cdc.RegisterConcrete(MsgUpdateNodeInfo{}, "x/vpn/msg_update_node_info", nil)
+	cdc.RegisterConcrete(MsgDeregisterNode{}, "x/vpn/msg_deregister_node", nil)
+	cdc.RegisterConcrete(MsgStartSubscription{}, "x/vpn/msg_start_subscription", nil)
+	cdc.RegisterConcrete(MsgUpdateSessionInfo{}, "x/vpn/msg_update_session_info", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+	codec.RegisterCrypto(msgCdc)
+}
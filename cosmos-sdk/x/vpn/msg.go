@@ -0,0 +1,258 @@
+package vpn
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	jscodec "github.com/baymax19/js2go/types"
+)
+
+const MsgRoute = "vpn"
+
+const (
+	MaxMonikerLength    = 128
+	MaxVersionLength    = 64
+	MaxEncryptionLength = 64
+)
+
+var (
+	_ types.Msg = MsgRegisterNode{}
+	_ types.Msg = MsgUpdateNodeInfo{}
+	_ types.Msg = MsgDeregisterNode{}
+	_ types.Msg = MsgStartSubscription{}
+	_ types.Msg = MsgUpdateSessionInfo{}
+)
+
+// MsgRegisterNode registers a VPN node owned by From.
+type MsgRegisterNode struct {
+	From          types.AccAddress `json:"from"`
+	T             string           `json:"type"`
+	Version       string           `json:"version"`
+	Moniker       string           `json:"moniker"`
+	PricesPerGB   types.Coins      `json:"prices_per_gb"`
+	InternetSpeed Bandwidth        `json:"internet_speed"`
+	Encryption    string           `json:"encryption"`
+}
+
+func NewMsgRegisterNode(from types.AccAddress, t, version, moniker string,
+	pricesPerGB types.Coins, internetSpeed Bandwidth, encryption string) MsgRegisterNode {
+	return MsgRegisterNode{
+		From:          from,
+		T:             t,
+		Version:       version,
+		Moniker:       moniker,
+		PricesPerGB:   pricesPerGB,
+		InternetSpeed: internetSpeed,
+		Encryption:    encryption,
+	}
+}
+
+func (msg MsgRegisterNode) Route() string { return MsgRoute }
+func (msg MsgRegisterNode) Type() string  { return "msg_register_node" }
+
+func (msg MsgRegisterNode) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.From}
+}
+
+func (msg MsgRegisterNode) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgRegisterNode) ValidateBasic() error {
+	if msg.From.Empty() {
+		return types.ErrInvalidAddress("from address is empty")
+	}
+	if msg.T == "" {
+		return types.ErrInvalidRequest("node type is empty")
+	}
+	if msg.Version == "" {
+		return types.ErrInvalidRequest("node version is empty")
+	}
+	if msg.Encryption == "" {
+		return types.ErrInvalidRequest("node encryption is empty")
+	}
+	if !msg.PricesPerGB.IsValid() || !msg.PricesPerGB.IsPositive() {
+		return types.ErrInvalidCoins("prices per GB must be positive, got %q", msg.PricesPerGB.String())
+	}
+	if !msg.InternetSpeed.IsPositive() {
+		return types.ErrInvalidRequest("internet speed must be positive")
+	}
+	return validateNodeInfo(msg.Version, msg.Moniker, msg.Encryption)
+}
+
+// MsgUpdateNodeInfo changes the details of node ID. Empty fields are left
+// unchanged.
+type MsgUpdateNodeInfo struct {
+	From          types.AccAddress `json:"from"`
+	ID            uint64           `json:"id"`
+	T             string           `json:"type"`
+	Version       string           `json:"version"`
+	Moniker       string           `json:"moniker"`
+	PricesPerGB   types.Coins      `json:"prices_per_gb"`
+	InternetSpeed Bandwidth        `json:"internet_speed"`
+	Encryption    string           `json:"encryption"`
+}
+
+func NewMsgUpdateNodeInfo(from types.AccAddress, id uint64, t, version, moniker string,
+	pricesPerGB types.Coins, internetSpeed Bandwidth, encryption string) MsgUpdateNodeInfo {
+	return MsgUpdateNodeInfo{
+		From:          from,
+		ID:            id,
+		T:             t,
+		Version:       version,
+		Moniker:       moniker,
+		PricesPerGB:   pricesPerGB,
+		InternetSpeed: internetSpeed,
+		Encryption:    encryption,
+	}
+}
+
+func (msg MsgUpdateNodeInfo) Route() string { return MsgRoute }
+func (msg MsgUpdateNodeInfo) Type() string  { return "msg_update_node_info" }
+
+func (msg MsgUpdateNodeInfo) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.From}
+}
+
+func (msg MsgUpdateNodeInfo) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgUpdateNodeInfo) ValidateBasic() error {
+	if msg.From.Empty() {
+		return types.ErrInvalidAddress("from address is empty")
+	}
+	if !msg.PricesPerGB.Empty() && (!msg.PricesPerGB.IsValid() || !msg.PricesPerGB.IsPositive()) {
+		return types.ErrInvalidCoins("prices per GB must be positive, got %q", msg.PricesPerGB.String())
+	}
+	if !msg.InternetSpeed.IsZero() && !msg.InternetSpeed.IsPositive() {
+		return types.ErrInvalidRequest("internet speed must be positive")
+	}
+	return validateNodeInfo(msg.Version, msg.Moniker, msg.Encryption)
+}
+
+// MsgDeregisterNode removes node ID.
+type MsgDeregisterNode struct {
+	From types.AccAddress `json:"from"`
+	ID   uint64           `json:"id"`
+}
+
+func NewMsgDeregisterNode(from types.AccAddress, id uint64) MsgDeregisterNode {
+	return MsgDeregisterNode{From: from, ID: id}
+}
+
+func (msg MsgDeregisterNode) Route() string { return MsgRoute }
+func (msg MsgDeregisterNode) Type() string  { return "msg_deregister_node" }
+
+func (msg MsgDeregisterNode) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.From}
+}
+
+func (msg MsgDeregisterNode) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgDeregisterNode) ValidateBasic() error {
+	if msg.From.Empty() {
+		return types.ErrInvalidAddress("from address is empty")
+	}
+	return nil
+}
+
+// MsgStartSubscription starts a subscription to node NodeID, locking Deposit
+// to pay for the bandwidth used.
+type MsgStartSubscription struct {
+	From    types.AccAddress `json:"from"`
+	NodeID  uint64           `json:"node_id"`
+	Deposit types.Coin       `json:"deposit"`
+}
+
+func NewMsgStartSubscription(from types.AccAddress, nodeID uint64, deposit types.Coin) MsgStartSubscription {
+	return MsgStartSubscription{
+		From:    from,
+		NodeID:  nodeID,
+		Deposit: deposit,
+	}
+}
+
+func (msg MsgStartSubscription) Route() string { return MsgRoute }
+func (msg MsgStartSubscription) Type() string  { return "msg_start_subscription" }
+
+func (msg MsgStartSubscription) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.From}
+}
+
+func (msg MsgStartSubscription) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgStartSubscription) ValidateBasic() error {
+	if msg.From.Empty() {
+		return types.ErrInvalidAddress("from address is empty")
+	}
+	if msg.Deposit.Denom == "" || !msg.Deposit.IsPositive() {
+		return types.ErrInvalidCoins("deposit must be positive, got %s", msg.Deposit)
+	}
+	return nil
+}
+
+// MsgUpdateSessionInfo pays for Bandwidth used in subscription
+// SubscriptionID out of its deposit. Both the client and the node owner sign
+// the bandwidth's BandwidthSignData.
+type MsgUpdateSessionInfo struct {
+	From               types.AccAddress  `json:"from"`
+	SubscriptionID     uint64            `json:"subscription_id"`
+	Bandwidth          Bandwidth         `json:"bandwidth"`
+	NodeOwnerSignature auth.StdSignature `json:"node_owner_signature"`
+	ClientSignature    auth.StdSignature `json:"client_signature"`
+}
+
+func NewMsgUpdateSessionInfo(from types.AccAddress, subscriptionID uint64, bandwidth Bandwidth,
+	nodeOwnerSignature, clientSignature auth.StdSignature) MsgUpdateSessionInfo {
+	return MsgUpdateSessionInfo{
+		From:               from,
+		SubscriptionID:     subscriptionID,
+		Bandwidth:          bandwidth,
+		NodeOwnerSignature: nodeOwnerSignature,
+		ClientSignature:    clientSignature,
+	}
+}
+
+func (msg MsgUpdateSessionInfo) Route() string { return MsgRoute }
+func (msg MsgUpdateSessionInfo) Type() string  { return "msg_update_session_info" }
+
+func (msg MsgUpdateSessionInfo) GetSigners() []types.AccAddress {
+	return []types.AccAddress{msg.From}
+}
+
+func (msg MsgUpdateSessionInfo) GetSignBytes() []byte {
+	return jscodec.MustSignBytes(msgCdc, msg)
+}
+
+func (msg MsgUpdateSessionInfo) ValidateBasic() error {
+	if msg.From.Empty() {
+		return types.ErrInvalidAddress("from address is empty")
+	}
+	if !msg.Bandwidth.IsPositive() {
+		return types.ErrInvalidRequest("bandwidth must be positive")
+	}
+	if msg.NodeOwnerSignature.PubKey == nil || len(msg.NodeOwnerSignature.Signature) == 0 {
+		return types.ErrInvalidRequest("node owner signature is empty")
+	}
+	if msg.ClientSignature.PubKey == nil || len(msg.ClientSignature.Signature) == 0 {
+		return types.ErrInvalidRequest("client signature is empty")
+	}
+	return nil
+}
+
+func validateNodeInfo(version, moniker, encryption string) error {
+	if len(version) > MaxVersionLength {
+		return types.ErrInvalidRequest("node version is longer than %d characters", MaxVersionLength)
+	}
+	if len(moniker) > MaxMonikerLength {
+		return types.ErrInvalidRequest("node moniker is longer than %d characters", MaxMonikerLength)
+	}
+	if len(encryption) > MaxEncryptionLength {
+		return types.ErrInvalidRequest("node encryption is longer than %d characters", MaxEncryptionLength)
+	}
+	return nil
+}
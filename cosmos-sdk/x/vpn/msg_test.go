@@ -0,0 +1,81 @@
+package vpn
+
+import (
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	nodeOwner = types.AccAddress([]byte("node_owner__________"))
+	client    = types.AccAddress([]byte("client______________"))
+	speed     = NewBandwidth(types.NewInt(1024), types.NewInt(2048))
+)
+
+// The expected sign bytes follow from the amino names in RegisterCodec and the
+// JSON tags of the messages. They are not taken from a transaction signed on
+// the hub.
+func TestMsgGetSignBytes(t *testing.T) {
+	prices := types.Coins{types.NewInt64Coin("sent", 100)}
+	sig := auth.StdSignature{Signature: []byte("signature")}
+
+	testCases := []struct {
+		msg      types.Msg
+		expected string
+	}{
+		{
+			NewMsgRegisterNode(nodeOwner, "OpenVPN", "0.1.0", "moniker", prices, speed, "AES-256-CBC"),
+			`{"type":"x/vpn/msg_register_node","value":{"encryption":"AES-256-CBC","from":"cosmos1dehkge2ldamkuetjta047h6lta047h6lf7qqkt","internet_speed":{"download":"2048","upload":"1024"},"moniker":"moniker","prices_per_gb":[{"amount":"100","denom":"sent"}],"type":"OpenVPN","version":"0.1.0"}}`,
+		},
+		{
+			NewMsgUpdateNodeInfo(nodeOwner, 1, "", "0.1.1", "", nil, speed, ""),
+			`{"type":"x/vpn/msg_update_node_info","value":{"encryption":"","from":"cosmos1dehkge2ldamkuetjta047h6lta047h6lf7qqkt","id":"1","internet_speed":{"download":"2048","upload":"1024"},"moniker":"","prices_per_gb":null,"type":"","version":"0.1.1"}}`,
+		},
+		{
+			NewMsgDeregisterNode(nodeOwner, 1),
+			`{"type":"x/vpn/msg_deregister_node","value":{"from":"cosmos1dehkge2ldamkuetjta047h6lta047h6lf7qqkt","id":"1"}}`,
+		},
+		{
+			NewMsgStartSubscription(client, 1, types.NewInt64Coin("sent", 100)),
+			`{"type":"x/vpn/msg_start_subscription","value":{"deposit":{"amount":"100","denom":"sent"},"from":"cosmos1vdkxjetww3047h6lta047h6lta047h6lccnkyy","node_id":"1"}}`,
+		},
+		{
+			NewMsgUpdateSessionInfo(client, 2, speed, sig, sig),
+			`{"type":"x/vpn/msg_update_session_info","value":{"bandwidth":{"download":"2048","upload":"1024"},"client_signature":{"pub_key":null,"signature":"c2lnbmF0dXJl"},"from":"cosmos1vdkxjetww3047h6lta047h6lta047h6lccnkyy","node_owner_signature":{"pub_key":null,"signature":"c2lnbmF0dXJl"},"subscription_id":"2"}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, string(tc.msg.GetSignBytes()), "unexpected sign bytes for test case #%d", i)
+	}
+}
+
+func TestBandwidthSignDataGetBytes(t *testing.T) {
+	data := NewBandwidthSignData(2, speed, nodeOwner, client)
+	require.Equal(t,
+		`{"bandwidth":{"download":"2048","upload":"1024"},"client":"cosmos1vdkxjetww3047h6lta047h6lta047h6lccnkyy","id":"2","node_owner":"cosmos1dehkge2ldamkuetjta047h6lta047h6lf7qqkt"}`,
+		string(data.GetBytes()))
+}
+
+func TestMsgUpdateNodeInfoValidateBasic(t *testing.T) {
+	testCases := []struct {
+		speed      Bandwidth
+		expectPass bool
+	}{
+		{speed, true},
+		{Bandwidth{}, true},
+		{NewBandwidth(types.ZeroInt(), types.ZeroInt()), true},
+		{NewBandwidth(types.NewInt(1024), types.ZeroInt()), false},
+		{NewBandwidth(types.ZeroInt(), types.NewInt(-1)), false},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgUpdateNodeInfo(nodeOwner, 1, "", "0.1.1", "", nil, tc.speed, "")
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test case #%d", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test case #%d", i)
+		}
+	}
+}
@@ -1,29 +1,59 @@
 package main
 
 import (
-	"github.com/baymax19/js2go/cosmos-sdk/client/keys"
+	"github.com/baymax19/js2go/cosmos-sdk/client"
+	keyscli "github.com/baymax19/js2go/cosmos-sdk/client/keys/cli"
 	"github.com/baymax19/js2go/cosmos-sdk/types"
-	"github.com/baymax19/js2go/cosmos-sdk/x/auth"
-	"github.com/baymax19/js2go/cosmos-sdk/x/bank"
+	"github.com/baymax19/js2go/cosmos-sdk/types/module"
+	authcli "github.com/baymax19/js2go/cosmos-sdk/x/auth/client/cli"
 	"github.com/baymax19/js2go/cosmos-sdk/x/bank/cli"
-	jtypes "github.com/baymax19/js2go/types"
+	distrcli "github.com/baymax19/js2go/cosmos-sdk/x/distribution/cli"
+	govcli "github.com/baymax19/js2go/cosmos-sdk/x/gov/cli"
+	slashingcli "github.com/baymax19/js2go/cosmos-sdk/x/slashing/cli"
+	stakingcli "github.com/baymax19/js2go/cosmos-sdk/x/staking/cli"
+	vpncli "github.com/baymax19/js2go/cosmos-sdk/x/vpn/cli"
 	"github.com/gopherjs/gopherjs/js"
 )
 
-var cdc = jtypes.Cdc
+// modules are the modules of the app. Add a module here to register
+// its messages in the codec and its functions in the exports.
+var modules = []module.Module{
+	keyscli.AppModule{},
+	authcli.AppModule{},
+	cli.AppModule{},
+	stakingcli.AppModule{},
+	distrcli.AppModule{},
+	govcli.AppModule{},
+	slashingcli.AppModule{},
+	vpncli.AppModule{},
+}
 
 func main() {
-	auth.RegisterCodec(cdc)
-	bank.RegisterCodec(cdc)
-	types.RegisterCodec(cdc)
-
+	app, err := module.NewRegistry(modules...)
+	if err != nil {
+		panic(err)
+	}
+
+	for name, fn := range appExports(app, types.DefaultBech32Config()) {
+		js.Module.Get("exports").Set(name, fn)
+	}
+}
 
-	js.Module.Get("exports").Set("createKey", keys.CreateKey)
-	js.Module.Get("exports").Set("sendCoins", cli.SendCoins)
+// appExports returns the exports of app for the chain with prefixes cfg,
+// plus withBech32, which returns them for other prefixes, see
+// client.Bech32ConfigFromJS.
+func appExports(app *module.Registry, cfg types.Bech32Config) map[string]interface{} {
+	exports := app.Exports(cfg)
 
+	exports["withBech32"] = func(config *js.Object) map[string]interface{} {
+		defer client.Recover()
 
-	//seed := "sound coral chimney claim humor peasant reward vanish desk trouble army door shallow insect fence typical ice tonight change dust reduce bracket ancient embark"
-	//data := cli.SendCoins("cosmos1v0m40792sx0cf69elugcqqxmqg3rdy7ra0j9kl", "cosmos1h7w6g8k8d2qflesnzyqap50zvldzvtgdmk7a6t", "1STAKE", seed)
-	//fmt.Println(data)
+		cfg, err := client.Bech32ConfigFromJS(config)
+		if err != nil {
+			client.Throw(err)
+		}
 
+		return appExports(app, cfg)
+	}
+	return exports
 }
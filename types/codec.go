@@ -1,9 +1,17 @@
 package types
 
-import "github.com/baymax19/js2go/codec"
+import (
+	"github.com/baymax19/js2go/codec"
+	"github.com/baymax19/js2go/cosmos-sdk/types"
+)
 
-var Cdc = codec.New()
-
-func init() {
-	codec.RegisterCrypto(Cdc)
+// MustSignBytes returns msg marshalled with cdc, with its keys sorted. Each
+// message package passes its own msgCdc, which decides whether the message is
+// wrapped in {type, value}, so the sign bytes don't depend on the app's codec.
+func MustSignBytes(cdc *codec.Codec, msg interface{}) []byte {
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return types.MustSortJSON(bz)
 }